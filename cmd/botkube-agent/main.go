@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/go-github/v53/github"
@@ -28,10 +29,12 @@ import (
 	intconfig "github.com/kubeshop/botkube/internal/config"
 	"github.com/kubeshop/botkube/internal/config/reloader"
 	"github.com/kubeshop/botkube/internal/config/remote"
+	"github.com/kubeshop/botkube/internal/delivery"
 	"github.com/kubeshop/botkube/internal/heartbeat"
 	"github.com/kubeshop/botkube/internal/httpx"
 	"github.com/kubeshop/botkube/internal/insights"
 	"github.com/kubeshop/botkube/internal/kubex"
+	"github.com/kubeshop/botkube/internal/leader"
 	"github.com/kubeshop/botkube/internal/lifecycle"
 	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/internal/plugin"
@@ -107,7 +110,10 @@ func run(ctx context.Context) (err error) {
 	}
 
 	statusReporter := status.GetReporter(remoteCfgEnabled, logger, gqlClient, deployClient, cfgVersion)
-	auditReporter := audit.GetReporter(remoteCfgEnabled, logger, gqlClient)
+	auditReporter, err := audit.GetReporter(remoteCfgEnabled, logger, gqlClient, conf.Settings.Audit)
+	if err != nil {
+		return fmt.Errorf("while creating audit reporter: %w", err)
+	}
 
 	// Set up analytics reporter
 	reporter, err := getAnalyticsReporter(conf.Analytics.Disable, logger)
@@ -182,7 +188,7 @@ func run(ctx context.Context) (err error) {
 	}
 
 	// Health endpoint
-	healthChecker := healthChecker{applicationStarted: false}
+	healthChecker := healthChecker{}
 	healthSrv := newHealthServer(logger.WithField(componentLogFieldKey, "Health server"), conf.Settings.HealthPort, &healthChecker)
 	errGroup.Go(func() error {
 		defer analytics.ReportPanicIfOccurs(logger, reporter)
@@ -196,24 +202,55 @@ func run(ctx context.Context) (err error) {
 		return metricsSrv.Serve(ctx)
 	})
 
+	// Leader election. Standby replicas keep plugins running and wait here until they become the leader.
+	if conf.Settings.LeaderElection.Enabled {
+		elector, err := leader.NewElector(logger.WithField(componentLogFieldKey, "Leader Elector"), conf.Settings.LeaderElection, k8sCli)
+		if err != nil {
+			return reportFatalError("while creating leader elector", err)
+		}
+
+		healthChecker.MarkAsStandby()
+		startedLeading := make(chan struct{})
+		errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(logger, reporter)
+			return elector.Run(ctx, func() { close(startedLeading) })
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-startedLeading:
+		}
+	}
+
 	cmdGuard := command.NewCommandGuard(logger.WithField(componentLogFieldKey, "Command Guard"), discoveryCli)
 	botkubeVersion, err := findVersions(k8sCli)
 	if err != nil {
 		return reportFatalError("while fetching versions", err)
 	}
+	deadLetterStore := delivery.NewDeadLetterStore(logger.WithField(componentLogFieldKey, "Dead Letter Store"), conf.Settings.DeliveryRetry.DeadLetter, k8sCli)
+	deliveryQueue := delivery.NewQueue(logger.WithField(componentLogFieldKey, "Delivery Queue"), conf.Settings.DeliveryRetry, deadLetterStore, reporter)
+
+	notifications := storage.NewForNotifications(conf.Settings.SystemConfigMap.Namespace, conf.Settings.SystemConfigMap.Name, k8sCli)
+	if err := notifications.Load(ctx); err != nil {
+		return reportFatalError("while loading notification actions state", err)
+	}
+
 	// Create executor factory
 	cfgManager := config.NewManager(remoteCfgEnabled, logger.WithField(componentLogFieldKey, "Config manager"), conf.Settings.PersistentConfig, cfgVersion, k8sCli, gqlClient, deployClient)
 	executorFactory, err := execute.NewExecutorFactory(
 		execute.DefaultExecutorFactoryParams{
-			Log:               logger.WithField(componentLogFieldKey, "Executor"),
-			Cfg:               *conf,
-			CfgManager:        cfgManager,
-			AnalyticsReporter: reporter,
-			CommandGuard:      cmdGuard,
-			PluginManager:     pluginManager,
-			BotKubeVersion:    botkubeVersion,
-			RestCfg:           kubeConfig,
-			AuditReporter:     auditReporter,
+			Log:                logger.WithField(componentLogFieldKey, "Executor"),
+			Cfg:                *conf,
+			CfgManager:         cfgManager,
+			AnalyticsReporter:  reporter,
+			CommandGuard:       cmdGuard,
+			PluginManager:      pluginManager,
+			BotKubeVersion:     botkubeVersion,
+			RestCfg:            kubeConfig,
+			AuditReporter:      auditReporter,
+			DeadLetterReplayer: deliveryQueue,
+			NotificationStore:  notifications,
 		},
 	)
 
@@ -222,7 +259,7 @@ func run(ctx context.Context) (err error) {
 	}
 
 	var (
-		sinkNotifiers []notifier.Sink
+		sinkNotifiers = map[string]notifier.Sink{}
 		bots          = map[string]bot.Bot{}
 	)
 
@@ -234,13 +271,21 @@ func run(ctx context.Context) (err error) {
 		commGroupLogger := logger.WithField(commGroupFieldKey, commGroupName)
 
 		scheduleBotNotifier := func(in bot.Bot) {
-			bots[fmt.Sprintf("%s-%s", commGroupName, in.IntegrationName())] = in
+			key := fmt.Sprintf("%s-%s", commGroupName, in.IntegrationName())
+			bots[key] = in
+			deliveryQueue.RegisterBot(key, in)
 			errGroup.Go(func() error {
 				defer analytics.ReportPanicIfOccurs(commGroupLogger, reporter)
 				return in.Start(ctx)
 			})
 		}
 
+		registerSinkNotifier := func(in notifier.Sink) {
+			key := fmt.Sprintf("%s-%s", commGroupName, in.IntegrationName())
+			sinkNotifiers[key] = in
+			deliveryQueue.RegisterSink(key, in)
+		}
+
 		// Run bots
 		if commGroupCfg.Slack.Enabled {
 			sb, err := bot.NewSlack(commGroupLogger.WithField(botLogFieldKey, "Slack"), commGroupName, commGroupCfg.Slack, executorFactory, reporter)
@@ -296,7 +341,7 @@ func run(ctx context.Context) (err error) {
 			if err != nil {
 				return reportFatalError("while creating Elasticsearch sink", err)
 			}
-			sinkNotifiers = append(sinkNotifiers, es)
+			registerSinkNotifier(es)
 		}
 
 		if commGroupCfg.Webhook.Enabled {
@@ -304,11 +349,15 @@ func run(ctx context.Context) (err error) {
 			if err != nil {
 				return reportFatalError("while creating Webhook sink", err)
 			}
-
-			sinkNotifiers = append(sinkNotifiers, wh)
+			registerSinkNotifier(wh)
 		}
 	}
 
+	errGroup.Go(func() error {
+		defer analytics.ReportPanicIfOccurs(logger, reporter)
+		return deliveryQueue.Start(ctx)
+	})
+
 	// TODO(https://github.com/kubeshop/botkube/issues/1011): Move restarter under `if conf.ConfigWatcher.Enabled {`
 	restarter := reloader.NewRestarter(
 		logger.WithField(componentLogFieldKey, "Restarter"),
@@ -390,7 +439,7 @@ func run(ctx context.Context) (err error) {
 
 	actionProvider := action.NewProvider(logger.WithField(componentLogFieldKey, "Action Provider"), conf.Actions, executorFactory)
 
-	sourcePluginDispatcher := source.NewDispatcher(logger, conf.Settings.ClusterName, bots, sinkNotifiers, pluginManager, actionProvider, reporter, auditReporter, kubeConfig)
+	sourcePluginDispatcher := source.NewDispatcher(logger, conf.Settings.ClusterName, bots, sinkNotifiers, pluginManager, actionProvider, reporter, auditReporter, deliveryQueue, kubeConfig, notifications)
 	scheduler := source.NewScheduler(logger, conf, sourcePluginDispatcher)
 	err = scheduler.Start(ctx)
 	if err != nil {
@@ -458,23 +507,40 @@ func newHealthServer(log logrus.FieldLogger, port string, healthChecker *healthC
 	return httpx.NewServer(log, addr, router)
 }
 
+// healthChecker reports the application state. It's used both for the liveness and readiness probes.
+// A standby replica is reported as ready, so rollouts don't wait for it to become the leader.
 type healthChecker struct {
-	applicationStarted bool
+	applicationStarted atomic.Bool
+	standby            atomic.Bool
 }
 
 func (h *healthChecker) MarkAsReady() {
-	h.applicationStarted = true
+	h.standby.Store(false)
+	h.applicationStarted.Store(true)
+}
+
+func (h *healthChecker) MarkAsStandby() {
+	h.standby.Store(true)
 }
 
 func (h *healthChecker) IsReady() bool {
-	return h.applicationStarted
+	return h.applicationStarted.Load()
 }
 
-func (h *healthChecker) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
-	if h.IsReady() {
+func (h *healthChecker) IsStandby() bool {
+	return h.standby.Load()
+}
+
+// ServeHTTP serves the health endpoint.
+func (h *healthChecker) ServeHTTP(resp http.ResponseWriter, _ *http.Request) {
+	switch {
+	case h.IsReady():
 		resp.WriteHeader(http.StatusOK)
 		fmt.Fprint(resp, "ok")
-	} else {
+	case h.IsStandby():
+		resp.WriteHeader(http.StatusOK)
+		fmt.Fprint(resp, "standby")
+	default:
 		resp.WriteHeader(http.StatusServiceUnavailable)
 		fmt.Fprint(resp, "unavailable")
 	}
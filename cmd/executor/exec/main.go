@@ -27,13 +27,16 @@ var version = "dev"
 const pluginName = "exec"
 
 // XExecutor implements Botkube executor plugin.
-type XExecutor struct{}
+type XExecutor struct {
+	templates *x.TemplateStore
+}
 
 func (i *XExecutor) Help(_ context.Context) (api.Message, error) {
 	help := heredoc.Doc(`
 		Usage:
 		  exec run [COMMAND] [FLAGS]    Run a specified command with optional flags
 		  exec install [SOURCE]         Install a binary using the https://github.com/zyedidia/eget syntax.
+		  exec reload templates         Download the configured templates again and start using them.
 		
 		Usage Examples:
 		  # Install the Helm CLI
@@ -63,6 +66,7 @@ type (
 	Commands struct {
 		Install *InstallCmd `arg:"subcommand:install"`
 		Run     *RunCmd     `arg:"subcommand:run"`
+		Reload  *ReloadCmd  `arg:"subcommand:reload"`
 	}
 	InstallCmd struct {
 		Tool []string `arg:"positional"`
@@ -70,6 +74,9 @@ type (
 	RunCmd struct {
 		Tool []string `arg:"positional"`
 	}
+	ReloadCmd struct {
+		Templates *struct{} `arg:"subcommand:templates"`
+	}
 )
 
 func escapePositionals(in string) string {
@@ -112,9 +119,10 @@ func (i *XExecutor) Execute(ctx context.Context, in executor.ExecuteInput) (exec
 
 	renderer := x.NewRenderer()
 	err = renderer.RegisterAll(map[string]x.Render{
-		"parser:table:.*": output.NewTableCommandParser(log),
-		"wrapper":         output.NewCommandWrapper(),
-		"tutorial":        output.NewTutorialWrapper(),
+		"parser:table:.*":    output.NewTableCommandParser(log),
+		"parser:(json|yaml)": output.NewDataCommandParser(log),
+		"wrapper":            output.NewCommandWrapper(),
+		"tutorial":           output.NewTutorialWrapper(),
 	})
 	if err != nil {
 		return executor.ExecuteOutput{}, fmt.Errorf("while registering message renderers: %v", err)
@@ -124,6 +132,26 @@ func (i *XExecutor) Execute(ctx context.Context, in executor.ExecuteInput) (exec
 
 	state := state.ExtractSlackState(in.Context.SlackState)
 
+	if cmd.Reload != nil {
+		if cmd.Reload.Templates == nil {
+			return executor.ExecuteOutput{
+				Message: api.NewPlaintextMessage("Only templates can be reloaded. Use 'exec reload templates'.", false),
+			}, nil
+		}
+		templates, err := i.templates.Reload(ctx, cfg)
+		if err != nil {
+			return executor.ExecuteOutput{}, err
+		}
+		return executor.ExecuteOutput{
+			Message: api.NewPlaintextMessage(fmt.Sprintf("Reloaded %d templates from %d sources.", len(templates), len(cfg.Templates)), false),
+		}, nil
+	}
+
+	templates, err := i.templates.Get(ctx, log, cfg)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while loading templates: %w", err)
+	}
+
 	switch {
 	case cmd.Run != nil:
 		tool := Normalize(strings.Join(cmd.Run.Tool, " "))
@@ -147,7 +175,7 @@ func (i *XExecutor) Execute(ctx context.Context, in executor.ExecuteInput) (exec
 			return out, nil
 		}
 
-		return runner.Run(ctx, cfg, state, command, run)
+		return runner.RunWithTemplates(templates, state, command, run)
 	case cmd.Install != nil:
 		var (
 			tool          = Normalize(strings.Join(cmd.Install.Tool, " "))
@@ -173,7 +201,7 @@ func (i *XExecutor) Execute(ctx context.Context, in executor.ExecuteInput) (exec
 			return "Binary was installed successfully 🎉", nil
 		}
 
-		return runner.Run(ctx, cfg, state, command, run)
+		return runner.RunWithTemplates(templates, state, command, run)
 	}
 	return executor.ExecuteOutput{
 		Message: api.NewPlaintextMessage("Command not supported", false),
@@ -200,7 +228,9 @@ func (i *XExecutor) getKubeconfig(ctx context.Context, log logrus.FieldLogger, i
 func main() {
 	executor.Serve(map[string]plugin.Plugin{
 		pluginName: &executor.Plugin{
-			Executor: &XExecutor{},
+			Executor: &XExecutor{
+				templates: x.NewTemplateStore(),
+			},
 		},
 	})
 }
@@ -226,6 +256,21 @@ func jsonSchema() api.JSONSchema {
 					"description": "It uses the go-getter library, which supports multiple URL formats (such as HTTP, Git repositories, or S3) and is able to unpack archives. For more details, see the documentation at https://github.com/hashicorp/go-getter.",
 					"type": "string",
 					"default": "%s"
+				  },
+				  "version": {
+					"title": "Version",
+					"description": "Pins the source to a given version, such as Git tag, branch, or commit SHA. It's passed to the go-getter as the 'ref' query parameter.",
+					"type": "string"
+				  },
+				  "refreshInterval": {
+					"title": "Refresh interval",
+					"description": "Defines how often the source is downloaded again, e.g. '10m'. If not set, the source is downloaded only once. Use 'exec reload templates' to download it on demand.",
+					"type": "string"
+				  },
+				  "checksum": {
+					"title": "Checksum",
+					"description": "Expected checksum of all YAML files from the source in the 'sha256:<hex>' format. If it doesn't match, the downloaded templates are rejected.",
+					"type": "string"
 				  }
 				},
 				"required": [
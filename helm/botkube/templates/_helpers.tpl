@@ -58,6 +58,24 @@ Create the name of the service account to use
 {{- end -}}
 {{- end -}}
 
+{{- define "botkube.source.prometheus.webhook.enabled" -}}
+{{- range $key, $val := .Values.sources -}}
+{{- $prom := index $val "botkube/prometheus" -}}
+{{- if and $prom $prom.enabled (eq (dig "config" "mode" "poll" $prom) "webhook") -}}
+  {{- true -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
+{{- define "botkube.source.webhook.enabled" -}}
+{{- range $key, $val := .Values.sources -}}
+{{- $webhook := index $val "botkube/webhook" -}}
+{{- if and $webhook $webhook.enabled -}}
+  {{- true -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
 {{- define "botkube.remoteConfigEnabled" -}}
 {{ if .Values.config.provider.identifier }}
     {{- true -}}
@@ -0,0 +1,182 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/kubeshop/botkube/internal/httpx"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+var _ AuditReporter = (*LocalAuditReporter)(nil)
+
+const (
+	executorAuditEventType = "CommandExecuted"
+	sourceAuditEventType   = "SourceEventEmitted"
+)
+
+// LocalAuditReporter writes audit events as JSON lines to stdout, a rotated file or a webhook.
+// It doesn't require Botkube Cloud.
+type LocalAuditReporter struct {
+	log    logrus.FieldLogger
+	output auditOutput
+}
+
+// auditRecord is a single line of the local audit log.
+type auditRecord struct {
+	Type         string          `json:"type"`
+	CreatedAt    string          `json:"createdAt"`
+	PluginName   string          `json:"pluginName"`
+	PlatformUser string          `json:"platformUser,omitempty"`
+	BotPlatform  string          `json:"botPlatform,omitempty"`
+	Command      string          `json:"command,omitempty"`
+	Channel      string          `json:"channel,omitempty"`
+	Event        json.RawMessage `json:"event,omitempty"`
+	Source       *auditSource    `json:"source,omitempty"`
+}
+
+type auditSource struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type auditOutput interface {
+	Write(ctx context.Context, line []byte) error
+}
+
+func newLocalAuditReporter(logger logrus.FieldLogger, cfg config.Audit) (*LocalAuditReporter, error) {
+	var output auditOutput
+	switch cfg.Type {
+	case config.StdoutAuditOutputType:
+		output = &writerOutput{w: os.Stdout}
+	case config.FileAuditOutputType:
+		if cfg.File.Path == "" {
+			return nil, fmt.Errorf("audit file path cannot be empty")
+		}
+		output = &writerOutput{w: &lumberjack.Logger{
+			Filename:   cfg.File.Path,
+			MaxSize:    cfg.File.MaxSizeMB,
+			MaxBackups: cfg.File.MaxBackups,
+			MaxAge:     cfg.File.MaxAgeDays,
+			Compress:   cfg.File.Compress,
+		}}
+	case config.WebhookAuditOutputType:
+		if cfg.Webhook.URL == "" {
+			return nil, fmt.Errorf("audit webhook URL cannot be empty")
+		}
+		output = &webhookOutput{url: cfg.Webhook.URL, cli: httpx.NewHTTPClient()}
+	default:
+		return nil, fmt.Errorf("unsupported audit output type %q", cfg.Type)
+	}
+
+	return &LocalAuditReporter{
+		log:    logger,
+		output: output,
+	}, nil
+}
+
+// ReportExecutorAuditEvent writes executor audit event.
+func (r *LocalAuditReporter) ReportExecutorAuditEvent(ctx context.Context, e ExecutorAuditEvent) error {
+	record := auditRecord{
+		Type:         executorAuditEventType,
+		CreatedAt:    e.CreatedAt,
+		PluginName:   e.PluginName,
+		PlatformUser: e.PlatformUser,
+		Command:      e.Command,
+		Channel:      e.Channel,
+	}
+	if e.BotPlatform != nil {
+		record.BotPlatform = string(*e.BotPlatform)
+	}
+
+	return r.write(ctx, record)
+}
+
+// ReportSourceAuditEvent writes source audit event.
+func (r *LocalAuditReporter) ReportSourceAuditEvent(ctx context.Context, e SourceAuditEvent) error {
+	record := auditRecord{
+		Type:       sourceAuditEventType,
+		CreatedAt:  e.CreatedAt,
+		PluginName: e.PluginName,
+		Source: &auditSource{
+			Name:        e.Source.Name,
+			DisplayName: e.Source.DisplayName,
+		},
+	}
+	if json.Valid([]byte(e.Event)) {
+		record.Event = json.RawMessage(e.Event)
+	} else {
+		rawEvent, err := json.Marshal(e.Event)
+		if err != nil {
+			return fmt.Errorf("while marshaling source event: %w", err)
+		}
+		record.Event = rawEvent
+	}
+
+	return r.write(ctx, record)
+}
+
+func (r *LocalAuditReporter) write(ctx context.Context, record auditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("while marshaling audit record: %w", err)
+	}
+
+	if err := r.output.Write(ctx, append(line, '\n')); err != nil {
+		return fmt.Errorf("while writing %s audit record: %w", record.Type, err)
+	}
+	return nil
+}
+
+// writerOutput writes audit records to a given writer. Writes are serialized, so lines are never interleaved.
+type writerOutput struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (o *writerOutput) Write(_ context.Context, line []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, err := o.w.Write(line)
+	return err
+}
+
+// webhookOutput posts each audit record to a given URL.
+type webhookOutput struct {
+	url string
+	cli *http.Client
+}
+
+func (o *webhookOutput) Write(ctx context.Context, line []byte) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.url, bytes.NewReader(line))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := o.cli.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		deferredErr := resp.Body.Close()
+		if deferredErr != nil {
+			err = multierror.Append(err, deferredErr)
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
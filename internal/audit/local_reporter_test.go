@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	remoteapi "github.com/kubeshop/botkube/internal/remote"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestLocalAuditReporterFileOutput(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "audit.log")
+	reporter, err := newLocalAuditReporter(loggerx.NewNoop(), config.Audit{
+		Enabled: true,
+		Type:    config.FileAuditOutputType,
+		File: config.AuditFile{
+			Path:      path,
+			MaxSizeMB: 1,
+		},
+	})
+	require.NoError(t, err)
+
+	platform := remoteapi.BotPlatformSlack
+	expected := `{"type":"CommandExecuted","createdAt":"2023-07-01T10:00:00Z","pluginName":"botkube/kubectl","platformUser":"U123","botPlatform":"SLACK","command":"kubectl get pods","channel":"general"}
+{"type":"SourceEventEmitted","createdAt":"2023-07-01T10:00:01Z","pluginName":"botkube/kubernetes","event":{"kind":"Pod"},"source":{"name":"k8s-events","displayName":"Kubernetes Events"}}
+`
+
+	// when
+	err = reporter.ReportExecutorAuditEvent(context.Background(), ExecutorAuditEvent{
+		CreatedAt:    "2023-07-01T10:00:00Z",
+		PluginName:   "botkube/kubectl",
+		PlatformUser: "U123",
+		BotPlatform:  &platform,
+		Command:      "kubectl get pods",
+		Channel:      "general",
+	})
+	require.NoError(t, err)
+
+	err = reporter.ReportSourceAuditEvent(context.Background(), SourceAuditEvent{
+		CreatedAt:  "2023-07-01T10:00:01Z",
+		PluginName: "botkube/kubernetes",
+		Event:      `{"kind":"Pod"}`,
+		Source: SourceDetails{
+			Name:        "k8s-events",
+			DisplayName: "Kubernetes Events",
+		},
+	})
+	require.NoError(t, err)
+
+	// then
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(got))
+}
+
+func TestLocalAuditReporterWebhookOutput(t *testing.T) {
+	// given
+	bodies := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies <- string(raw)
+
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	reporter, err := newLocalAuditReporter(loggerx.NewNoop(), config.Audit{
+		Enabled: true,
+		Type:    config.WebhookAuditOutputType,
+		Webhook: config.AuditWebhook{URL: srv.URL},
+	})
+	require.NoError(t, err)
+
+	// when
+	err = reporter.ReportSourceAuditEvent(context.Background(), SourceAuditEvent{
+		PluginName: "botkube/cm-watcher",
+		Event:      "ConfigMap updated",
+		Source:     SourceDetails{Name: "cm-watcher"},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `{"type":"SourceEventEmitted","createdAt":"","pluginName":"botkube/cm-watcher","event":"ConfigMap updated","source":{"name":"cm-watcher","displayName":""}}`+"\n", <-bodies)
+
+	// given
+	reporter, err = newLocalAuditReporter(loggerx.NewNoop(), config.Audit{
+		Enabled: true,
+		Type:    config.WebhookAuditOutputType,
+		Webhook: config.AuditWebhook{URL: srv.URL + "/broken"},
+	})
+	require.NoError(t, err)
+
+	// when
+	err = reporter.ReportExecutorAuditEvent(context.Background(), ExecutorAuditEvent{PluginName: "botkube/kubectl"})
+
+	// then
+	<-bodies
+	assert.EqualError(t, err, "while writing CommandExecuted audit record: unexpected status code 500")
+}
+
+func TestGetReporter(t *testing.T) {
+	// when
+	reporter, err := GetReporter(false, loggerx.NewNoop(), nil, config.Audit{Enabled: false})
+
+	// then
+	require.NoError(t, err)
+	assert.IsType(t, &NoopAuditReporter{}, reporter)
+
+	// when
+	_, err = GetReporter(false, loggerx.NewNoop(), nil, config.Audit{Enabled: true, Type: config.FileAuditOutputType})
+
+	// then
+	assert.EqualError(t, err, "while creating local audit reporter: audit file path cannot be empty")
+}
@@ -2,10 +2,13 @@ package audit
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/sirupsen/logrus"
 
 	remoteapi "github.com/kubeshop/botkube/internal/remote"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/multierror"
 )
 
 // AuditReporter defines interface for reporting audit events
@@ -37,10 +40,54 @@ type SourceDetails struct {
 	DisplayName string
 }
 
-// GetReporter creates new AuditReporter
-func GetReporter(remoteCfgSyncEnabled bool, logger logrus.FieldLogger, gql GraphQLClient) AuditReporter {
+// GetReporter creates new AuditReporter. When both remote config sync and the local audit log are enabled,
+// events are reported to both of them.
+func GetReporter(remoteCfgSyncEnabled bool, logger logrus.FieldLogger, gql GraphQLClient, cfg config.Audit) (AuditReporter, error) {
+	var reporters multiAuditReporter
 	if remoteCfgSyncEnabled {
-		return newGraphQLAuditReporter(logger.WithField("component", "GraphQLAuditReporter"), gql)
+		reporters = append(reporters, newGraphQLAuditReporter(logger.WithField("component", "GraphQLAuditReporter"), gql))
 	}
-	return newNoopAuditReporter(nil)
+	if cfg.Enabled {
+		local, err := newLocalAuditReporter(logger.WithField("component", "LocalAuditReporter"), cfg)
+		if err != nil {
+			return nil, fmt.Errorf("while creating local audit reporter: %w", err)
+		}
+		reporters = append(reporters, local)
+	}
+
+	switch len(reporters) {
+	case 0:
+		return newNoopAuditReporter(nil), nil
+	case 1:
+		return reporters[0], nil
+	default:
+		return reporters, nil
+	}
+}
+
+var _ AuditReporter = multiAuditReporter(nil)
+
+// multiAuditReporter reports audit events to all underlying reporters.
+type multiAuditReporter []AuditReporter
+
+// ReportExecutorAuditEvent reports executor audit event to all reporters.
+func (m multiAuditReporter) ReportExecutorAuditEvent(ctx context.Context, e ExecutorAuditEvent) error {
+	issues := multierror.New()
+	for _, r := range m {
+		if err := r.ReportExecutorAuditEvent(ctx, e); err != nil {
+			issues = multierror.Append(issues, err)
+		}
+	}
+	return issues.ErrorOrNil()
+}
+
+// ReportSourceAuditEvent reports source audit event to all reporters.
+func (m multiAuditReporter) ReportSourceAuditEvent(ctx context.Context, e SourceAuditEvent) error {
+	issues := multierror.New()
+	for _, r := range m {
+		if err := r.ReportSourceAuditEvent(ctx, e); err != nil {
+			issues = multierror.Append(issues, err)
+		}
+	}
+	return issues.ErrorOrNil()
 }
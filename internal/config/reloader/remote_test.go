@@ -153,6 +153,38 @@ func fixConfig(actionEnabled bool) config.Config {
 				Name:      "botkube-system",
 				Namespace: "botkube",
 			},
+			DeliveryRetry: config.DeliveryRetry{
+				QueueSize:      100,
+				MaxAttempts:    5,
+				InitialBackoff: time.Second,
+				MaxBackoff:     time.Minute,
+				DeadLetter: config.DeadLetter{
+					MaxItems: 100,
+				},
+			},
+			Audit: config.Audit{
+				Type: config.StdoutAuditOutputType,
+				File: config.AuditFile{
+					Path:       "/tmp/botkube-audit.log",
+					MaxSizeMB:  100,
+					MaxBackups: 3,
+					MaxAgeDays: 30,
+				},
+			},
+			LeaderElection: config.LeaderElection{
+				Lease: config.K8sResourceRef{
+					Name:      "botkube-leader",
+					Namespace: "botkube",
+				},
+				LeaseDuration: 15 * time.Second,
+				RenewDeadline: 10 * time.Second,
+				RetryPeriod:   2 * time.Second,
+			},
+			OutputPagination: config.OutputPagination{
+				PageSize: 30,
+				MaxPages: 20,
+				CacheTTL: time.Hour,
+			},
 		},
 		Plugins: config.PluginManagement{
 			CacheDir: "/tmp",
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/kubeshop/botkube/internal/metrics"
 	"github.com/kubeshop/botkube/pkg/config"
 )
 
@@ -62,6 +63,7 @@ func (r *Restarter) Do(ctx context.Context) error {
 		[]byte(restartData),
 		metav1.PatchOptions{FieldManager: fieldManagerName},
 	)
+	metrics.ReportConfigReload(err)
 	if err != nil {
 		return fmt.Errorf("while restarting the Deployment: %w", err)
 	}
@@ -0,0 +1,230 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const deadLetterKey = "dead-letter"
+
+// NewDeadLetterStore returns the dead letter store based on a given configuration.
+func NewDeadLetterStore(log logrus.FieldLogger, cfg config.DeadLetter, k8sCli kubernetes.Interface) DeadLetterStore {
+	switch {
+	case cfg.ConfigMap.Name != "":
+		log.Infof("Using ConfigMap %s/%s as a dead letter store.", cfg.ConfigMap.Namespace, cfg.ConfigMap.Name)
+		return &ConfigMapDeadLetterStore{cfg: cfg, k8sCli: k8sCli}
+	case cfg.FileName != "":
+		log.Infof("Using file %q as a dead letter store.", cfg.FileName)
+		return &FileDeadLetterStore{cfg: cfg}
+	default:
+		log.Info("Using in-memory dead letter store.")
+		return &MemoryDeadLetterStore{maxItems: cfg.MaxItems}
+	}
+}
+
+// MemoryDeadLetterStore keeps dead letters in memory. They are lost on restart.
+type MemoryDeadLetterStore struct {
+	mu       sync.Mutex
+	maxItems int
+	items    []Item
+}
+
+// Add adds a given item to the store.
+func (s *MemoryDeadLetterStore) Add(_ context.Context, item Item) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items = appendWithLimit(s.items, item, s.maxItems)
+	return nil
+}
+
+// Drain returns all stored items and removes them from the store.
+func (s *MemoryDeadLetterStore) Drain(_ context.Context) ([]Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := s.items
+	s.items = nil
+	return out, nil
+}
+
+// FileDeadLetterStore keeps dead letters in a local JSON file.
+type FileDeadLetterStore struct {
+	mu  sync.Mutex
+	cfg config.DeadLetter
+}
+
+// Add adds a given item to the store.
+func (s *FileDeadLetterStore) Add(_ context.Context, item Item) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items, err := s.read()
+	if err != nil {
+		return err
+	}
+	return s.write(appendWithLimit(items, item, s.cfg.MaxItems))
+}
+
+// Drain returns all stored items and removes them from the store.
+func (s *FileDeadLetterStore) Drain(_ context.Context) ([]Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.write(nil); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *FileDeadLetterStore) read() ([]Item, error) {
+	raw, err := os.ReadFile(filepath.Clean(s.cfg.FileName))
+	switch {
+	case err == nil:
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("while reading dead letter file: %w", err)
+	}
+
+	return unmarshalItems(raw)
+}
+
+func (s *FileDeadLetterStore) write(items []Item) error {
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("while marshaling dead letter items: %w", err)
+	}
+
+	if err := os.WriteFile(s.cfg.FileName, raw, 0o600); err != nil {
+		return fmt.Errorf("while writing dead letter file: %w", err)
+	}
+	return nil
+}
+
+// ConfigMapDeadLetterStore keeps dead letters in a Kubernetes ConfigMap.
+type ConfigMapDeadLetterStore struct {
+	mu     sync.Mutex
+	cfg    config.DeadLetter
+	k8sCli kubernetes.Interface
+}
+
+// Add adds a given item to the store.
+func (s *ConfigMapDeadLetterStore) Add(ctx context.Context, item Item) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cm, items, err := s.get(ctx)
+	if err != nil {
+		return err
+	}
+	return s.save(ctx, cm, appendWithLimit(items, item, s.cfg.MaxItems))
+}
+
+// Drain returns all stored items and removes them from the store.
+func (s *ConfigMapDeadLetterStore) Drain(ctx context.Context) ([]Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cm, items, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	if err := s.save(ctx, cm, nil); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *ConfigMapDeadLetterStore) get(ctx context.Context) (*corev1.ConfigMap, []Item, error) {
+	ref := s.cfg.ConfigMap
+	cm, err := s.k8sCli.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		return nil, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("while getting the dead letter ConfigMap: %w", err)
+	}
+
+	items, err := unmarshalItems([]byte(cm.Data[deadLetterKey]))
+	if err != nil {
+		return nil, nil, err
+	}
+	return cm, items, nil
+}
+
+func (s *ConfigMapDeadLetterStore) save(ctx context.Context, cm *corev1.ConfigMap, items []Item) error {
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("while marshaling dead letter items: %w", err)
+	}
+
+	if cm == nil {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.cfg.ConfigMap.Name,
+				Namespace: s.cfg.ConfigMap.Namespace,
+			},
+			Data: map[string]string{
+				deadLetterKey: string(raw),
+			},
+		}
+		_, err = s.k8sCli.CoreV1().ConfigMaps(cm.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("while creating the dead letter ConfigMap: %w", err)
+		}
+		return nil
+	}
+
+	newCM := cm.DeepCopy()
+	if newCM.Data == nil {
+		newCM.Data = map[string]string{}
+	}
+	newCM.Data[deadLetterKey] = string(raw)
+
+	_, err = s.k8sCli.CoreV1().ConfigMaps(newCM.Namespace).Update(ctx, newCM, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("while updating the dead letter ConfigMap: %w", err)
+	}
+	return nil
+}
+
+func unmarshalItems(raw []byte) ([]Item, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var items []Item
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("while unmarshaling dead letter items: %w", err)
+	}
+	return items, nil
+}
+
+// appendWithLimit appends a given item and drops the oldest ones if the limit is exceeded.
+func appendWithLimit(items []Item, item Item, limit int) []Item {
+	items = append(items, item)
+	if limit > 0 && len(items) > limit {
+		items = items[len(items)-limit:]
+	}
+	return items
+}
@@ -0,0 +1,186 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/internal/syncx"
+	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+const (
+	// livingMessageTTL defines how long a message is edited in place after the last update.
+	livingMessageTTL = 24 * time.Hour
+	// livingMessageMaxItems limits the number of tracked living messages.
+	livingMessageMaxItems = 1000
+)
+
+type livingMessageEntry struct {
+	// refs holds posted messages by channel.
+	refs map[string]notifier.MessageRef
+	// missing holds channels where the message wasn't posted yet.
+	missing   []string
+	updatedAt time.Time
+}
+
+// livingMessages keeps references to messages posted for a given living message key,
+// so follow-up updates edit the posted messages instead of posting new ones.
+type livingMessages struct {
+	ttl      time.Duration
+	maxItems int
+	now      func() time.Time
+
+	// keyLocks serializes sending messages for a given key, while mu guards only the items map.
+	keyLocks syncx.KeyedMutex
+
+	mu    sync.Mutex
+	items map[string]livingMessageEntry
+}
+
+func newLivingMessages() *livingMessages {
+	return &livingMessages{
+		ttl:      livingMessageTTL,
+		maxItems: livingMessageMaxItems,
+		now:      time.Now,
+		items:    map[string]livingMessageEntry{},
+	}
+}
+
+// Send posts a message for a given item. Messages already posted for the item living message key are edited in place,
+// and the message is posted to channels where it wasn't posted yet.
+func (l *livingMessages) Send(ctx context.Context, bot notifier.EditableBot, item Item) error {
+	key := fmt.Sprintf("%s/%s", item.NotifierKey, item.LivingMessageKey)
+
+	// Messages for a given key are sent sequentially to make sure that a single message is posted.
+	unlock := l.keyLocks.Lock(key)
+	defer unlock()
+
+	entry, found := l.get(key)
+	if !found {
+		refs, err := bot.SendMessage(ctx, *item.Message, item.Sources, item.Channels)
+		failed, _ := notifier.FailedChannels(err)
+		if len(refs) > 0 || len(failed) > 0 {
+			l.set(key, livingMessageEntry{refs: refsByChannel(refs), missing: failed})
+		}
+		return err
+	}
+
+	var failed []string
+	errs := multierror.New()
+	for channel, ref := range entry.refs {
+		if len(notifier.FilterChannels([]string{channel}, item.Channels)) == 0 {
+			continue
+		}
+		if err := bot.EditMessage(ctx, ref, *item.Message); err != nil {
+			// The message might have been deleted, so the next attempt posts a new one.
+			delete(entry.refs, channel)
+			entry.missing = append(entry.missing, channel)
+			failed = append(failed, channel)
+			errs = multierror.Append(errs, fmt.Errorf("while editing message in channel %q: %w", channel, err))
+		}
+	}
+
+	// Channels where editing failed are posted to on the next attempt.
+	// Empty channels list means all channels, so post only if there are any channels left.
+	missing := withoutChannels(notifier.FilterChannels(entry.missing, item.Channels), failed)
+	if len(missing) > 0 {
+		refs, err := bot.SendMessage(ctx, *item.Message, item.Sources, missing)
+		for _, ref := range refs {
+			entry.refs[ref.Channel] = ref
+		}
+		entry.missing = withoutChannels(entry.missing, channelsOf(refs))
+		if err != nil {
+			postFailed, _ := notifier.FailedChannels(err)
+			failed = append(failed, postFailed...)
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	l.set(key, entry)
+	return notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
+}
+
+func (l *livingMessages) get(key string) (livingMessageEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, found := l.items[key]
+	if !found {
+		return livingMessageEntry{}, false
+	}
+	if l.now().Sub(entry.updatedAt) > l.ttl {
+		delete(l.items, key)
+		return livingMessageEntry{}, false
+	}
+	return entry, true
+}
+
+func (l *livingMessages) set(key string, entry livingMessageEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, found := l.items[key]; !found && len(l.items) >= l.maxItems {
+		l.evict()
+	}
+	entry.updatedAt = l.now()
+	l.items[key] = entry
+}
+
+// evict removes expired entries. If there are none, the least recently updated entry is removed.
+// It must be called with the mutex held.
+func (l *livingMessages) evict() {
+	var (
+		oldestKey string
+		oldest    time.Time
+	)
+	for key, entry := range l.items {
+		if l.now().Sub(entry.updatedAt) > l.ttl {
+			delete(l.items, key)
+			continue
+		}
+		if oldestKey == "" || entry.updatedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.updatedAt
+		}
+	}
+
+	if len(l.items) >= l.maxItems {
+		delete(l.items, oldestKey)
+	}
+}
+
+func refsByChannel(refs []notifier.MessageRef) map[string]notifier.MessageRef {
+	out := make(map[string]notifier.MessageRef, len(refs))
+	for _, ref := range refs {
+		out[ref.Channel] = ref
+	}
+	return out
+}
+
+func channelsOf(refs []notifier.MessageRef) []string {
+	out := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		out = append(out, ref.Channel)
+	}
+	return out
+}
+
+// withoutChannels returns channels which are not present on the excluded list.
+func withoutChannels(channels, excluded []string) []string {
+	var out []string
+	for _, ch := range channels {
+		isExcluded := false
+		for _, excludedCh := range excluded {
+			if ch == excludedCh {
+				isExcluded = true
+				break
+			}
+		}
+		if !isExcluded {
+			out = append(out, ch)
+		}
+	}
+	return out
+}
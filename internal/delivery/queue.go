@@ -0,0 +1,327 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+const deadLetterTimeout = 10 * time.Second
+
+// Item holds a single notification delivery. It is persisted in the dead letter store when it cannot be delivered.
+type Item struct {
+	NotifierKey string   `json:"notifierKey"`
+	PluginName  string   `json:"pluginName"`
+	Sources     []string `json:"sources"`
+	// Channels limits the delivery to given channels. It's set to channels where the previous delivery attempt failed.
+	Channels         []string                 `json:"channels,omitempty"`
+	Message          *interactive.CoreMessage `json:"message,omitempty"`
+	Event            any                      `json:"event,omitempty"`
+	CorrelationKey   string                   `json:"correlationKey,omitempty"`
+	LivingMessageKey string                   `json:"livingMessageKey,omitempty"`
+	AnalyticsLabels  map[string]interface{}   `json:"analyticsLabels,omitempty"`
+	Attempts         int                      `json:"attempts"`
+	LastError        string                   `json:"lastError,omitempty"`
+	FailedAt         time.Time                `json:"failedAt,omitempty"`
+}
+
+// ResultFn is called once a given item was delivered or all delivery attempts failed.
+type ResultFn func(err error)
+
+// DeadLetterStore stores notifications that couldn't be delivered.
+type DeadLetterStore interface {
+	// Add adds a given item to the store.
+	Add(ctx context.Context, item Item) error
+	// Drain returns all stored items and removes them from the store.
+	Drain(ctx context.Context) ([]Item, error)
+}
+
+// Queue delivers notifications using a bounded queue per notifier. Failed deliveries are retried with exponential backoff and jitter,
+// and moved to the dead letter store once all attempts are exhausted.
+type Queue struct {
+	log      logrus.FieldLogger
+	cfg      config.DeliveryRetry
+	store    DeadLetterStore
+	reporter analytics.FatalErrorAnalyticsReporter
+	now      func() time.Time
+
+	mu      sync.RWMutex
+	bots    map[string]notifier.Bot
+	sinks   map[string]notifier.Sink
+	pending map[string]*notifierQueue
+	living  *livingMessages
+}
+
+// notifierQueue holds deliveries for a single notifier. Failed deliveries wait for the next attempt in a separate delay queue,
+// so they don't block new deliveries.
+type notifierQueue struct {
+	items   chan envelope
+	delayed []envelope
+}
+
+type envelope struct {
+	item          Item
+	onResult      ResultFn
+	nextAttemptAt time.Time
+}
+
+// NewQueue returns a new Queue instance.
+func NewQueue(log logrus.FieldLogger, cfg config.DeliveryRetry, store DeadLetterStore, reporter analytics.FatalErrorAnalyticsReporter) *Queue {
+	return &Queue{
+		log:      log,
+		cfg:      cfg,
+		store:    store,
+		reporter: reporter,
+		now:      time.Now,
+		bots:     map[string]notifier.Bot{},
+		sinks:    map[string]notifier.Sink{},
+		pending:  map[string]*notifierQueue{},
+		living:   newLivingMessages(),
+	}
+}
+
+// RegisterBot registers a bot notifier under a given key.
+func (q *Queue) RegisterBot(key string, n notifier.Bot) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.bots[key] = n
+	q.pending[key] = &notifierQueue{items: make(chan envelope, q.cfg.QueueSize)}
+}
+
+// RegisterSink registers a sink notifier under a given key.
+func (q *Queue) RegisterSink(key string, n notifier.Sink) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.sinks[key] = n
+	q.pending[key] = &notifierQueue{items: make(chan envelope, q.cfg.QueueSize)}
+}
+
+// Start starts delivery workers for all registered notifiers. It blocks until the context is canceled.
+func (q *Queue) Start(ctx context.Context) error {
+	if !q.cfg.Enabled {
+		q.log.Info("Delivery retries are disabled. Notifications are sent only once.")
+		return nil
+	}
+
+	q.mu.RLock()
+	var wg sync.WaitGroup
+	for key, nq := range q.pending {
+		wg.Add(1)
+		go func(key string, nq *notifierQueue) {
+			defer analytics.ReportPanicIfOccurs(q.log, q.reporter)
+			defer wg.Done()
+			q.runWorker(ctx, key, nq)
+		}(key, nq)
+	}
+	q.mu.RUnlock()
+
+	wg.Wait()
+	return nil
+}
+
+// Enqueue schedules a given item for delivery. The onResult function is optional.
+func (q *Queue) Enqueue(ctx context.Context, item Item, onResult ResultFn) {
+	env := envelope{item: item, onResult: onResult}
+
+	if !q.cfg.Enabled {
+		go func() {
+			defer analytics.ReportPanicIfOccurs(q.log, q.reporter)
+			env.finish(q.deliver(ctx, env.item))
+		}()
+		return
+	}
+
+	q.mu.RLock()
+	nq, found := q.pending[item.NotifierKey]
+	q.mu.RUnlock()
+	if !found {
+		env.finish(fmt.Errorf("notifier %q is not registered", item.NotifierKey))
+		return
+	}
+
+	select {
+	case nq.items <- env:
+	default:
+		err := fmt.Errorf("delivery queue for %q is full", item.NotifierKey)
+		q.moveToDeadLetter(env.item, err)
+		env.finish(err)
+	}
+}
+
+// ReplayDeadLetters enqueues all notifications from the dead letter store again. It returns the number of replayed items.
+func (q *Queue) ReplayDeadLetters(ctx context.Context) (int, error) {
+	items, err := q.store.Drain(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("while getting dead letter items: %w", err)
+	}
+
+	for _, item := range items {
+		item.Attempts = 0
+		item.LastError = ""
+		item.FailedAt = time.Time{}
+		q.Enqueue(ctx, item, nil)
+	}
+
+	return len(items), nil
+}
+
+// runWorker delivers items of a given notifier one by one. Items waiting for the next attempt are delivered
+// once their backoff elapses, interleaved with new items.
+func (q *Queue) runWorker(ctx context.Context, key string, nq *notifierQueue) {
+	log := q.log.WithField("notifier", key)
+	for {
+		if env, found := nq.popDue(q.now()); found {
+			q.attempt(ctx, log, nq, env)
+			continue
+		}
+
+		var (
+			timer      *time.Timer
+			retryTimer <-chan time.Time
+		)
+		if len(nq.delayed) > 0 {
+			timer = time.NewTimer(nq.delayed[0].nextAttemptAt.Sub(q.now()))
+			retryTimer = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			for _, env := range nq.delayed {
+				env.finish(ctx.Err())
+			}
+			return
+		case env := <-nq.items:
+			q.attempt(ctx, log, nq, env)
+		case <-retryTimer:
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// attempt delivers a given item once. If the delivery fails, the item is scheduled for the next attempt
+// or moved to the dead letter store once all attempts are exhausted.
+func (q *Queue) attempt(ctx context.Context, log logrus.FieldLogger, nq *notifierQueue, env envelope) {
+	env.item.Attempts++
+	err := q.deliver(ctx, env.item)
+	if channels, ok := notifier.FailedChannels(err); ok {
+		// channels which already got the message are not notified again
+		env.item.Channels = channels
+	}
+
+	if err == nil || ctx.Err() != nil {
+		env.finish(err)
+		return
+	}
+
+	if env.item.Attempts >= q.cfg.MaxAttempts {
+		q.moveToDeadLetter(env.item, err)
+		env.finish(err)
+		return
+	}
+
+	if len(nq.delayed) >= q.cfg.QueueSize {
+		err = fmt.Errorf("delivery retry queue for %q is full: %w", env.item.NotifierKey, err)
+		q.moveToDeadLetter(env.item, err)
+		env.finish(err)
+		return
+	}
+
+	log.Warnf("Delivery attempt (%d) failed: %s", env.item.Attempts, err.Error())
+	env.nextAttemptAt = q.now().Add(q.backoff(env.item.Attempts))
+	nq.delay(env)
+}
+
+// backoff returns the delay before the next attempt. It grows exponentially with the number of attempts
+// up to the max backoff, and is randomized by up to the initial backoff.
+func (q *Queue) backoff(attempts int) time.Duration {
+	delay := q.cfg.InitialBackoff
+	for i := 1; i < attempts && delay > 0 && (q.cfg.MaxBackoff <= 0 || delay < q.cfg.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if q.cfg.MaxBackoff > 0 && delay > q.cfg.MaxBackoff {
+		delay = q.cfg.MaxBackoff
+	}
+	if q.cfg.InitialBackoff > 0 {
+		delay += time.Duration(rand.Int63n(int64(q.cfg.InitialBackoff))) // #nosec G404
+	}
+	return delay
+}
+
+func (q *Queue) deliver(ctx context.Context, item Item) error {
+	q.mu.RLock()
+	bot, isBot := q.bots[item.NotifierKey]
+	sink, isSink := q.sinks[item.NotifierKey]
+	q.mu.RUnlock()
+
+	switch {
+	case isBot && item.Message != nil:
+		if editable, ok := bot.(notifier.EditableBot); ok && item.LivingMessageKey != "" {
+			return q.living.Send(ctx, editable, item)
+		}
+		if threaded, ok := bot.(notifier.ThreadedBot); ok && item.CorrelationKey != "" {
+			return threaded.SendCorrelatedMessage(ctx, *item.Message, item.Sources, item.Channels, item.CorrelationKey)
+		}
+		_, err := bot.SendMessage(ctx, *item.Message, item.Sources, item.Channels)
+		return err
+	case isSink:
+		return sink.SendEvent(ctx, item.Event, item.Sources)
+	case isBot:
+		return errors.New("bot notifier requires a message")
+	default:
+		return fmt.Errorf("notifier %q is not registered", item.NotifierKey)
+	}
+}
+
+func (q *Queue) moveToDeadLetter(item Item, deliveryErr error) {
+	item.LastError = deliveryErr.Error()
+	item.FailedAt = time.Now()
+
+	// use separate ctx as the delivery ctx might be already cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), deadLetterTimeout)
+	defer cancel()
+	if err := q.store.Add(ctx, item); err != nil {
+		q.log.WithField("notifier", item.NotifierKey).Errorf("while adding item to the dead letter store: %s", err.Error())
+	}
+}
+
+// delay adds a given envelope to the delay queue, which is sorted by the next attempt time.
+// It's called only by the notifier worker, so it doesn't need synchronization.
+func (nq *notifierQueue) delay(env envelope) {
+	idx := len(nq.delayed)
+	for idx > 0 && nq.delayed[idx-1].nextAttemptAt.After(env.nextAttemptAt) {
+		idx--
+	}
+	nq.delayed = append(nq.delayed, envelope{})
+	copy(nq.delayed[idx+1:], nq.delayed[idx:])
+	nq.delayed[idx] = env
+}
+
+// popDue removes and returns the first envelope from the delay queue if its next attempt time has passed.
+func (nq *notifierQueue) popDue(now time.Time) (envelope, bool) {
+	if len(nq.delayed) == 0 || nq.delayed[0].nextAttemptAt.After(now) {
+		return envelope{}, false
+	}
+	env := nq.delayed[0]
+	nq.delayed = nq.delayed[1:]
+	return env, true
+}
+
+func (e envelope) finish(err error) {
+	if e.onResult == nil {
+		return
+	}
+	e.onResult(err)
+}
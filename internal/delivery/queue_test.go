@@ -0,0 +1,437 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+func TestQueueMovesFailedDeliveriesToDeadLetterAndReplays(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.DeliveryRetry{
+		Enabled:        true,
+		QueueSize:      10,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	}
+	store := &MemoryDeadLetterStore{maxItems: 10}
+	bot := &fakeBot{failuresLeft: 3}
+
+	queue := NewQueue(loggerx.NewNoop(), cfg, store, analytics.NewNoopReporter())
+	queue.RegisterBot("default-socketSlack", bot)
+	go func() {
+		_ = queue.Start(ctx)
+	}()
+
+	msg := interactive.CoreMessage{Message: api.NewPlaintextMessage("Pod crashed", false)}
+
+	// when
+	results := make(chan error, 1)
+	queue.Enqueue(ctx, Item{
+		NotifierKey: "default-socketSlack",
+		Sources:     []string{"k8s-events"},
+		Message:     &msg,
+	}, func(err error) {
+		results <- err
+	})
+
+	// then
+	select {
+	case err := <-results:
+		assert.EqualError(t, err, "slack is down")
+	case <-time.After(time.Second):
+		t.Fatal("delivery result was not reported")
+	}
+	assert.Equal(t, 3, bot.Calls())
+
+	items, err := store.Drain(ctx)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, 3, items[0].Attempts)
+	assert.Equal(t, "slack is down", items[0].LastError)
+
+	// when
+	require.NoError(t, store.Add(ctx, items[0]))
+	replayed, err := queue.ReplayDeadLetters(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, replayed)
+	assert.Eventually(t, func() bool {
+		return bot.Calls() == 4
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"Pod crashed"}, bot.Delivered())
+}
+
+func TestQueueRetriesOnlyFailedChannels(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.DeliveryRetry{
+		Enabled:        true,
+		QueueSize:      10,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	}
+	store := &MemoryDeadLetterStore{maxItems: 10}
+	bot := &fakeBot{
+		channels:        []string{"general", "alerts", "ops"},
+		channelFailures: map[string]int{"alerts": 2},
+	}
+
+	queue := NewQueue(loggerx.NewNoop(), cfg, store, analytics.NewNoopReporter())
+	queue.RegisterBot("default-socketSlack", bot)
+	go func() {
+		_ = queue.Start(ctx)
+	}()
+
+	msg := interactive.CoreMessage{Message: api.NewPlaintextMessage("Pod crashed", false)}
+
+	// when
+	results := make(chan error, 1)
+	queue.Enqueue(ctx, Item{
+		NotifierKey: "default-socketSlack",
+		Sources:     []string{"k8s-events"},
+		Message:     &msg,
+	}, func(err error) {
+		results <- err
+	})
+
+	// then
+	select {
+	case err := <-results:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("delivery result was not reported")
+	}
+	assert.Equal(t, 3, bot.Calls())
+	assert.Equal(t, []string{"general:Pod crashed", "ops:Pod crashed", "alerts:Pod crashed"}, bot.DeliveredTo())
+}
+
+func TestQueueDoesNotBlockNewDeliveriesOnRetries(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.DeliveryRetry{
+		Enabled:        true,
+		QueueSize:      2,
+		MaxAttempts:    3,
+		InitialBackoff: time.Hour,
+		MaxBackoff:     time.Hour,
+	}
+	store := &MemoryDeadLetterStore{maxItems: 10}
+	bot := &fakeBot{failuresLeft: 1}
+
+	queue := NewQueue(loggerx.NewNoop(), cfg, store, analytics.NewNoopReporter())
+	queue.RegisterBot("default-socketSlack", bot)
+	go func() {
+		_ = queue.Start(ctx)
+	}()
+
+	send := func(text string) chan error {
+		msg := interactive.CoreMessage{Message: api.NewPlaintextMessage(text, false)}
+		results := make(chan error, 1)
+		queue.Enqueue(ctx, Item{
+			NotifierKey: "default-socketSlack",
+			Sources:     []string{"k8s-events"},
+			Message:     &msg,
+		}, func(err error) {
+			results <- err
+		})
+		return results
+	}
+
+	// when
+	failed := send("Pod crashed")
+	require.Eventually(t, func() bool {
+		return bot.Calls() == 1
+	}, time.Second, 5*time.Millisecond)
+	delivered := []chan error{send("Pod created"), send("Pod deleted")}
+
+	// then
+	for _, results := range delivered {
+		select {
+		case err := <-results:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("delivery result was not reported")
+		}
+	}
+	assert.Equal(t, []string{"Pod created", "Pod deleted"}, bot.Delivered())
+
+	items, err := store.Drain(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	// when
+	cancel()
+
+	// then
+	select {
+	case err := <-failed:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("delivery result was not reported")
+	}
+}
+
+func TestQueueWithRetriesDisabled(t *testing.T) {
+	// given
+	store := &MemoryDeadLetterStore{}
+	bot := &fakeBot{failuresLeft: 1}
+	queue := NewQueue(loggerx.NewNoop(), config.DeliveryRetry{Enabled: false}, store, analytics.NewNoopReporter())
+	queue.RegisterBot("default-mattermost", bot)
+
+	msg := interactive.CoreMessage{Message: api.NewPlaintextMessage("Pod crashed", false)}
+
+	// when
+	results := make(chan error, 1)
+	queue.Enqueue(context.Background(), Item{
+		NotifierKey: "default-mattermost",
+		Message:     &msg,
+	}, func(err error) {
+		results <- err
+	})
+
+	// then
+	select {
+	case err := <-results:
+		assert.EqualError(t, err, "slack is down")
+	case <-time.After(time.Second):
+		t.Fatal("delivery result was not reported")
+	}
+	assert.Equal(t, 1, bot.Calls())
+
+	items, err := store.Drain(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestQueueEditsLivingMessages(t *testing.T) {
+	// given
+	store := &MemoryDeadLetterStore{}
+	bot := &fakeBot{}
+	queue := NewQueue(loggerx.NewNoop(), config.DeliveryRetry{Enabled: false}, store, analytics.NewNoopReporter())
+	queue.RegisterBot("default-socketSlack", bot)
+
+	send := func(text string) error {
+		msg := interactive.CoreMessage{Message: api.NewPlaintextMessage(text, false)}
+		results := make(chan error, 1)
+		queue.Enqueue(context.Background(), Item{
+			NotifierKey:      "default-socketSlack",
+			Sources:          []string{"k8s-events"},
+			Message:          &msg,
+			LivingMessageKey: "kubernetes/Deployment/default/nginx",
+		}, func(err error) {
+			results <- err
+		})
+
+		select {
+		case err := <-results:
+			return err
+		case <-time.After(time.Second):
+			t.Fatal("delivery result was not reported")
+			return nil
+		}
+	}
+
+	// when
+	require.NoError(t, send("Deployment created"))
+	require.NoError(t, send("Deployment updated"))
+	require.NoError(t, send("Deployment rolled out"))
+
+	// then
+	assert.Equal(t, []string{"Deployment created"}, bot.Delivered())
+	assert.Equal(t, []string{"1:Deployment updated", "1:Deployment rolled out"}, bot.Edited())
+
+	// when the message cannot be edited anymore
+	bot.FailEdits()
+	err := send("Deployment scaled")
+
+	// then the next update posts a new message
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `while editing message in channel "general": message not found`)
+	require.NoError(t, send("Deployment scaled"))
+	assert.Equal(t, []string{"Deployment created", "Deployment scaled"}, bot.Delivered())
+}
+
+func TestQueueLivingMessagesRetryOnlyFailedChannels(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.DeliveryRetry{
+		Enabled:        true,
+		QueueSize:      10,
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	}
+	bot := &fakeBot{
+		channels:        []string{"general", "alerts"},
+		channelFailures: map[string]int{"alerts": 1},
+	}
+	queue := NewQueue(loggerx.NewNoop(), cfg, &MemoryDeadLetterStore{maxItems: 10}, analytics.NewNoopReporter())
+	queue.RegisterBot("default-socketSlack", bot)
+	go func() {
+		_ = queue.Start(ctx)
+	}()
+
+	send := func(text string) error {
+		msg := interactive.CoreMessage{Message: api.NewPlaintextMessage(text, false)}
+		results := make(chan error, 1)
+		queue.Enqueue(ctx, Item{
+			NotifierKey:      "default-socketSlack",
+			Sources:          []string{"k8s-events"},
+			Message:          &msg,
+			LivingMessageKey: "kubernetes/Deployment/default/nginx/uid",
+		}, func(err error) {
+			results <- err
+		})
+
+		select {
+		case err := <-results:
+			return err
+		case <-time.After(time.Second):
+			t.Fatal("delivery result was not reported")
+			return nil
+		}
+	}
+
+	// when
+	require.NoError(t, send("Deployment created"))
+	require.NoError(t, send("Deployment updated"))
+
+	// then the retry posts only to the failed channel, and updates edit messages in all channels
+	assert.Equal(t, []string{"general:Deployment created", "alerts:Deployment created"}, bot.DeliveredTo())
+	assert.ElementsMatch(t, []string{"1:Deployment updated", "2:Deployment updated"}, bot.Edited())
+}
+
+type fakeBot struct {
+	mu           sync.Mutex
+	channels     []string
+	failuresLeft int
+	// channelFailures holds the number of failures left for a given channel.
+	channelFailures map[string]int
+	failEdits       bool
+	calls           int
+	delivered       []string
+	deliveredTo     []string
+	edited          []string
+}
+
+func (f *fakeBot) SendMessageToAll(context.Context, interactive.CoreMessage) error {
+	return nil
+}
+
+func (f *fakeBot) SendMessage(_ context.Context, msg interactive.CoreMessage, _ []string, channels []string) ([]notifier.MessageRef, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.failuresLeft > 0 {
+		f.failuresLeft--
+		return nil, errors.New("slack is down")
+	}
+
+	allChannels := f.channels
+	if len(allChannels) == 0 {
+		allChannels = []string{"general"}
+	}
+
+	var (
+		refs   []notifier.MessageRef
+		failed []string
+	)
+	for _, channel := range notifier.FilterChannels(allChannels, channels) {
+		if f.channelFailures[channel] > 0 {
+			f.channelFailures[channel]--
+			failed = append(failed, channel)
+			continue
+		}
+		f.delivered = append(f.delivered, msg.BaseBody.Plaintext)
+		f.deliveredTo = append(f.deliveredTo, channel+":"+msg.BaseBody.Plaintext)
+		refs = append(refs, notifier.MessageRef{Channel: channel, ChannelID: channel, MessageID: strconv.Itoa(len(f.delivered))})
+	}
+	if len(failed) > 0 {
+		return refs, notifier.NewChannelDeliveryError(failed, fmt.Errorf("rate limited in %s", strings.Join(failed, ", ")))
+	}
+	return refs, nil
+}
+
+func (f *fakeBot) ChannelsToNotify([]string) []string {
+	if len(f.channels) == 0 {
+		return []string{"general"}
+	}
+	return f.channels
+}
+
+func (f *fakeBot) EditMessage(_ context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failEdits {
+		f.failEdits = false
+		return errors.New("message not found")
+	}
+	f.edited = append(f.edited, ref.MessageID+":"+msg.BaseBody.Plaintext)
+	return nil
+}
+
+func (f *fakeBot) IntegrationName() config.CommPlatformIntegration {
+	return config.SocketSlackCommPlatformIntegration
+}
+
+func (f *fakeBot) Type() config.IntegrationType {
+	return config.BotIntegrationType
+}
+
+func (f *fakeBot) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func (f *fakeBot) Edited() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.edited
+}
+
+func (f *fakeBot) FailEdits() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failEdits = true
+}
+
+func (f *fakeBot) Delivered() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.delivered
+}
+
+func (f *fakeBot) DeliveredTo() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.deliveredTo
+}
@@ -0,0 +1,315 @@
+package flux
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/executor/x/state"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const (
+	builderIndicator         = "@builder"
+	kindDropdownCommand      = "@builder --kind"
+	namespaceDropdownCommand = "@builder --namespace"
+	nameDropdownCommand      = "@builder --name"
+	actionDropdownCommand    = "@builder --action"
+)
+
+var (
+	// builderKinds holds Flux resource kinds that can be reconciled, suspended and resumed.
+	builderKinds = []string{
+		"kustomization",
+		"helmrelease",
+		"source git",
+		"source helm",
+		"source oci",
+		"source bucket",
+	}
+	builderActions = []string{"reconcile", "suspend", "resume"}
+)
+
+// builderState holds values selected in the interactive command builder.
+type builderState struct {
+	blockID   string
+	kind      string
+	namespace string
+	name      string
+	action    string
+}
+
+// Command returns the Flux command constructed from the selected values.
+// It returns an empty string if not all required values were selected yet.
+func (s builderState) Command() string {
+	if s.kind == "" || s.name == "" || s.action == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s %s %s %s -n %s", PluginName, s.action, s.kind, s.name, s.namespace)
+}
+
+// fluxObject represents a single Flux object printed by the 'flux get' command.
+type fluxObject struct {
+	namespace string
+	name      string
+}
+
+// BuilderCmdService provides functionality to construct Flux commands with interactive dropdowns.
+type BuilderCmdService struct {
+	log            logrus.FieldLogger
+	executeCommand func(ctx context.Context, rawCmd string, mutators ...pluginx.ExecuteCommandMutation) (pluginx.ExecuteCommandOutput, error)
+}
+
+// NewBuilderCmdService returns a new BuilderCmdService instance.
+func NewBuilderCmdService(log logrus.FieldLogger) *BuilderCmdService {
+	return &BuilderCmdService{
+		log:            log,
+		executeCommand: pluginx.ExecuteCommand,
+	}
+}
+
+// ShouldHandle returns true if commands should be handled by this service.
+func (b *BuilderCmdService) ShouldHandle(command string) bool {
+	args := trimPluginName(command)
+	return args == "" || strings.HasPrefix(args, builderIndicator)
+}
+
+// Run renders dropdowns to select a Flux resource kind, namespace, name and action.
+// Once all values are selected, it renders a command preview with the run button.
+func (b *BuilderCmdService) Run(ctx context.Context, command string, st *state.Container, kubeConfigPath string) (executor.ExecuteOutput, error) {
+	details := b.extractState(command, st)
+	if details.namespace == "" {
+		details.namespace = defaultNamespace
+	}
+
+	b.log.WithFields(logrus.Fields{
+		"kind":      details.kind,
+		"namespace": details.namespace,
+		"name":      details.name,
+		"action":    details.action,
+	}).Debug("Extracted Slack state")
+
+	// the initial message is sent only to the user, follow-up selections update it in place.
+	replaceOriginal := details.blockID != ""
+	if details.blockID == "" {
+		blockID, err := uuid.NewRandom()
+		if err != nil {
+			return executor.ExecuteOutput{}, err
+		}
+		details.blockID = blockID.String()
+	}
+
+	selects := []api.Select{
+		*builderSelect("Select resource kind", kindDropdownCommand, "Kinds", stringOptions(builderKinds), details.kind),
+	}
+
+	var sections []api.Section
+	if details.kind != "" {
+		objects, err := b.listObjects(ctx, details.kind, kubeConfigPath)
+		if err != nil {
+			return executor.ExecuteOutput{}, err
+		}
+
+		nameOptions := nameOptionsInNamespace(objects, details.namespace)
+		if !containsOption(nameOptions, details.name) {
+			details.name = ""
+		}
+
+		selects = append(selects, *builderSelect("Select namespace", namespaceDropdownCommand, "Namespaces", namespaceOptions(objects, details.namespace), details.namespace))
+		if sel := builderSelect("Select name", nameDropdownCommand, "Names", nameOptions, details.name); sel != nil {
+			selects = append(selects, *sel)
+		} else {
+			sections = append(sections, api.Section{
+				Base: api.Base{
+					Body: api.Body{
+						Plaintext: fmt.Sprintf("There are no %s resources in the %q namespace.", details.kind, details.namespace),
+					},
+				},
+			})
+		}
+	}
+
+	if details.name != "" {
+		selects = append(selects, *builderSelect("Select action", actionDropdownCommand, "Actions", stringOptions(builderActions), details.action))
+	}
+
+	if cmd := details.Command(); cmd != "" {
+		btn := api.ButtonBuilder{}
+		sections = append(sections,
+			api.Section{
+				Base: api.Base{
+					Body: api.Body{
+						CodeBlock: cmd,
+					},
+				},
+			},
+			api.Section{
+				Buttons: api.Buttons{
+					btn.ForCommandWithoutDesc(interactive.RunCommandName, cmd, api.ButtonStylePrimary),
+				},
+			},
+		)
+	}
+
+	return executor.ExecuteOutput{
+		Message: api.Message{
+			ReplaceOriginal:   replaceOriginal,
+			OnlyVisibleForYou: true,
+			Sections: append([]api.Section{
+				{
+					Selects: api.Selects{
+						ID:    details.blockID,
+						Items: selects,
+					},
+				},
+			}, sections...),
+		},
+	}, nil
+}
+
+// extractState returns values selected in the builder dropdowns. The value selected by the
+// triggering dropdown is taken from the command, as it's the most recent one.
+func (b *BuilderCmdService) extractState(command string, st *state.Container) builderState {
+	details := builderState{
+		blockID:   st.GetSelectsBlockID(),
+		kind:      st.GetField(builderFieldID(kindDropdownCommand)),
+		namespace: st.GetField(builderFieldID(namespaceDropdownCommand)),
+		name:      st.GetField(builderFieldID(nameDropdownCommand)),
+		action:    st.GetField(builderFieldID(actionDropdownCommand)),
+	}
+
+	args := trimPluginName(command)
+	switch {
+	case strings.HasPrefix(args, kindDropdownCommand):
+		// names are scoped to a given kind and namespace, so the previous selection is not valid anymore.
+		details.kind = selectedValue(args, kindDropdownCommand)
+		details.name = ""
+	case strings.HasPrefix(args, namespaceDropdownCommand):
+		details.namespace = selectedValue(args, namespaceDropdownCommand)
+		details.name = ""
+	case strings.HasPrefix(args, nameDropdownCommand):
+		details.name = selectedValue(args, nameDropdownCommand)
+	case strings.HasPrefix(args, actionDropdownCommand):
+		details.action = selectedValue(args, actionDropdownCommand)
+	}
+	return details
+}
+
+// listObjects returns Flux objects of a given kind from all namespaces.
+func (b *BuilderCmdService) listObjects(ctx context.Context, kind, kubeConfigPath string) ([]fluxObject, error) {
+	cmd := fmt.Sprintf("%s get %s -A --no-header", PluginName, kind)
+	out, err := b.executeCommand(ctx, cmd, pluginx.ExecuteCommandEnvs(map[string]string{
+		"KUBECONFIG": kubeConfigPath,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("while listing %s resources: %w", kind, err)
+	}
+
+	var objects []fluxObject
+	for _, line := range strings.Split(out.Stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		objects = append(objects, fluxObject{
+			namespace: fields[0],
+			name:      fields[1],
+		})
+	}
+	return objects, nil
+}
+
+// namespaceOptions returns namespaces with at least one object. The selected namespace is always included.
+func namespaceOptions(objects []fluxObject, selected string) []api.OptionItem {
+	unique := map[string]struct{}{
+		selected: {},
+	}
+	for _, obj := range objects {
+		unique[obj.namespace] = struct{}{}
+	}
+
+	var namespaces []string
+	for ns := range unique {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return stringOptions(namespaces)
+}
+
+func nameOptionsInNamespace(objects []fluxObject, namespace string) []api.OptionItem {
+	var names []string
+	for _, obj := range objects {
+		if obj.namespace != namespace {
+			continue
+		}
+		names = append(names, obj.name)
+	}
+	return stringOptions(names)
+}
+
+func stringOptions(in []string) []api.OptionItem {
+	var options []api.OptionItem
+	for _, item := range in {
+		options = append(options, api.OptionItem{
+			Name:  item,
+			Value: item,
+		})
+	}
+	return options
+}
+
+// builderSelect returns a dropdown for a given builder command. It returns nil if there are no options to select.
+func builderSelect(name, cmd, group string, options []api.OptionItem, selected string) *api.Select {
+	if len(options) == 0 {
+		return nil
+	}
+
+	var initial *api.OptionItem
+	for _, opt := range options {
+		if opt.Value == selected {
+			opt := opt
+			initial = &opt
+		}
+	}
+
+	return &api.Select{
+		Name:    name,
+		Command: fmt.Sprintf("%s %s %s", api.MessageBotNamePlaceholder, PluginName, cmd),
+		OptionGroups: []api.OptionGroup{
+			{
+				Name:    group,
+				Options: options,
+			},
+		},
+		InitialOption: initial,
+	}
+}
+
+func containsOption(options []api.OptionItem, value string) bool {
+	for _, opt := range options {
+		if opt.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// builderFieldID returns the ID under which a given dropdown value is stored in the message state.
+func builderFieldID(cmd string) string {
+	return fmt.Sprintf("%s %s", PluginName, cmd)
+}
+
+func selectedValue(args, cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(args, cmd))
+}
+
+func trimPluginName(cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(cmd), PluginName))
+}
@@ -0,0 +1,92 @@
+package flux
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/executor/x/state"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+func TestBuilderCmdServiceShouldHandle(t *testing.T) {
+	svc := NewBuilderCmdService(loggerx.NewNoop())
+
+	assert.True(t, svc.ShouldHandle("flux"))
+	assert.True(t, svc.ShouldHandle("flux @builder --kind kustomization"))
+	assert.False(t, svc.ShouldHandle("flux get kustomizations"))
+}
+
+func TestBuilderCmdServiceRun(t *testing.T) {
+	// given
+	svc := NewBuilderCmdService(loggerx.NewNoop())
+	svc.executeCommand = func(ctx context.Context, rawCmd string, mutators ...pluginx.ExecuteCommandMutation) (pluginx.ExecuteCommandOutput, error) {
+		if rawCmd != "flux get source git -A --no-header" {
+			return pluginx.ExecuteCommandOutput{}, fmt.Errorf("unexpected command %q", rawCmd)
+		}
+		return pluginx.ExecuteCommandOutput{Stdout: "flux-system\tflux-system\tmain@sha1:a1b2\tFalse\tTrue\tstored artifact\n" +
+			"apps\tpodinfo\tmaster@sha1:c3d4\tFalse\tTrue\tstored artifact\n"}, nil
+	}
+	run := func(cmd string, fields map[string]string) api.Message {
+		var st *state.Container
+		if fields != nil {
+			st = &state.Container{SelectsBlockID: "dropdowns", Fields: fields}
+		}
+		out, err := svc.Run(context.Background(), cmd, st, "kubeconfig")
+		require.NoError(t, err)
+		return out.Message
+	}
+
+	// when
+	initial := run("flux", nil)
+
+	// then
+	assert.False(t, initial.ReplaceOriginal)
+	assert.True(t, initial.OnlyVisibleForYou)
+	require.Len(t, initial.Sections, 1)
+	require.Len(t, initial.Sections[0].Selects.Items, 1)
+	assert.Equal(t, "{{BotName}} flux @builder --kind", initial.Sections[0].Selects.Items[0].Command)
+
+	// when
+	withKind := run("flux @builder --kind source git", map[string]string{})
+
+	// then
+	assert.True(t, withKind.ReplaceOriginal)
+	assert.Equal(t, "dropdowns", withKind.Sections[0].Selects.ID)
+	selects := withKind.Sections[0].Selects.Items
+	require.Len(t, selects, 3)
+	assert.Equal(t, "source git", selects[0].InitialOption.Value)
+	assert.Equal(t, []api.OptionItem{{Name: "apps", Value: "apps"}, {Name: "flux-system", Value: "flux-system"}}, selects[1].OptionGroups[0].Options)
+	assert.Equal(t, "flux-system", selects[1].InitialOption.Value)
+	assert.Equal(t, []api.OptionItem{{Name: "flux-system", Value: "flux-system"}}, selects[2].OptionGroups[0].Options)
+
+	// when
+	withNamespace := run("flux @builder --namespace apps", map[string]string{
+		"flux @builder --kind": "source git",
+		"flux @builder --name": "flux-system",
+	})
+
+	// then
+	selects = withNamespace.Sections[0].Selects.Items
+	require.Len(t, selects, 3, "name from the previous namespace should be cleared")
+	assert.Nil(t, selects[2].InitialOption)
+	assert.Equal(t, []api.OptionItem{{Name: "podinfo", Value: "podinfo"}}, selects[2].OptionGroups[0].Options)
+
+	// when
+	withAction := run("flux @builder --action suspend", map[string]string{
+		"flux @builder --kind":      "source git",
+		"flux @builder --namespace": "apps",
+		"flux @builder --name":      "podinfo",
+	})
+
+	// then
+	require.Len(t, withAction.Sections, 3)
+	assert.Equal(t, "suspend", withAction.Sections[0].Selects.Items[3].InitialOption.Value)
+	assert.Equal(t, "flux suspend source git podinfo -n apps", withAction.Sections[1].Body.CodeBlock)
+	assert.Equal(t, "{{BotName}} flux suspend source git podinfo -n apps", withAction.Sections[2].Buttons[0].Command)
+}
@@ -104,6 +104,11 @@ func (d *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (execu
 
 	log.WithField("rawCommand", cmd).Info("Processing command...")
 
+	builderHandler := NewBuilderCmdService(log)
+	if in.Context.IsInteractivitySupported && builderHandler.ShouldHandle(cmd) {
+		return builderHandler.Run(ctx, cmd, state.ExtractSlackState(in.Context.SlackState), kubeConfigPath)
+	}
+
 	diffHandler := NewKustomizeDiffCmdService(d.cache, log)
 	if diffCmd, shouldHandle := diffHandler.ShouldHandle(in.Command); shouldHandle {
 		return diffHandler.Run(ctx, diffCmd, kubeConfigPath, in.Context.KubeConfig, cfg)
@@ -0,0 +1,360 @@
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/kubeshop/botkube/internal/executor/x/state"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const (
+	builderIndicator         = "@builder"
+	actionDropdownCommand    = "@builder --action"
+	namespaceDropdownCommand = "@builder --namespace"
+	releaseDropdownCommand   = "@builder --release"
+	revisionDropdownCommand  = "@builder --revision"
+	rollbackAction           = "rollback"
+	dropdownItemsLimit       = 100
+)
+
+// builderActions holds Helm commands that can be constructed with the interactive builder.
+var builderActions = []string{
+	"status",
+	"history",
+	"get values",
+	"get manifest",
+	"get notes",
+	"test",
+	rollbackAction,
+	"uninstall",
+}
+
+// helmListItem represents a single release printed by the 'helm list' command in the JSON format.
+type helmListItem struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Chart     string `json:"chart"`
+	Status    string `json:"status"`
+}
+
+// helmHistoryItem represents a single revision printed by the 'helm history' command in the JSON format.
+type helmHistoryItem struct {
+	Revision    int    `json:"revision"`
+	Status      string `json:"status"`
+	Chart       string `json:"chart"`
+	AppVersion  string `json:"app_version"`
+	Description string `json:"description"`
+}
+
+// builderState holds values selected in the interactive command builder.
+type builderState struct {
+	blockID   string
+	action    string
+	namespace string
+	release   string
+	revision  string
+}
+
+// Command returns the Helm command constructed from the selected values.
+// It returns an empty string if not all required values were selected yet.
+func (s builderState) Command() string {
+	if s.action == "" || s.release == "" {
+		return ""
+	}
+	if s.action != rollbackAction {
+		return fmt.Sprintf("helm %s %s -n %s", s.action, s.release, s.namespace)
+	}
+	if s.revision == "" {
+		return ""
+	}
+	return fmt.Sprintf("helm rollback %s %s -n %s", s.release, s.revision, s.namespace)
+}
+
+// isBuilderCommand returns true if a given command should be handled by the interactive builder.
+func isBuilderCommand(cmd string) bool {
+	args := trimPluginName(cmd)
+	return args == "" || strings.HasPrefix(args, builderIndicator)
+}
+
+// extractBuilderState returns values selected in the builder dropdowns. The value selected by the
+// triggering dropdown is taken from the command, as it's the most recent one.
+func extractBuilderState(cmd string, st *state.Container) builderState {
+	details := builderState{
+		blockID:   st.GetSelectsBlockID(),
+		action:    st.GetField(builderFieldID(actionDropdownCommand)),
+		namespace: st.GetField(builderFieldID(namespaceDropdownCommand)),
+		release:   st.GetField(builderFieldID(releaseDropdownCommand)),
+		revision:  st.GetField(builderFieldID(revisionDropdownCommand)),
+	}
+
+	args := trimPluginName(cmd)
+	switch {
+	case strings.HasPrefix(args, actionDropdownCommand):
+		details.action = selectedValue(args, actionDropdownCommand)
+		if details.action != rollbackAction {
+			details.revision = ""
+		}
+	case strings.HasPrefix(args, namespaceDropdownCommand):
+		// the release names are scoped to a given namespace, so the previous selection is not valid anymore.
+		details.namespace = selectedValue(args, namespaceDropdownCommand)
+		details.release = ""
+		details.revision = ""
+	case strings.HasPrefix(args, releaseDropdownCommand):
+		details.release = selectedValue(args, releaseDropdownCommand)
+		details.revision = ""
+	case strings.HasPrefix(args, revisionDropdownCommand):
+		details.revision = selectedValue(args, revisionDropdownCommand)
+	}
+	return details
+}
+
+// handleBuilder renders dropdowns to select a Helm action, namespace, release, and revision if needed.
+// Once all required values are selected, it renders a command preview with the run button.
+func (e *Executor) handleBuilder(ctx context.Context, details builderState, defaultNamespace string, envs map[string]string) (api.Message, error) {
+	if details.namespace == "" {
+		details.namespace = defaultNamespace
+	}
+
+	releases, err := e.listReleases(ctx, envs)
+	if err != nil {
+		return api.Message{}, err
+	}
+
+	// the initial message is sent only to the user, follow-up selections update it in place.
+	replaceOriginal := details.blockID != ""
+	if details.blockID == "" {
+		blockID, err := uuid.NewRandom()
+		if err != nil {
+			return api.Message{}, err
+		}
+		details.blockID = blockID.String()
+	}
+
+	releaseOptions := releaseOptionsInNamespace(releases, details.namespace)
+	if !containsOption(releaseOptions, details.release) {
+		details.release = ""
+		details.revision = ""
+	}
+
+	selects := []api.Select{
+		*builderSelect("Select action", actionDropdownCommand, "Actions", actionOptions(), details.action),
+		*builderSelect("Select namespace", namespaceDropdownCommand, "Namespaces", namespaceOptions(releases, details.namespace), details.namespace),
+	}
+
+	var sections []api.Section
+	if sel := builderSelect("Select release", releaseDropdownCommand, "Releases", releaseOptions, details.release); sel != nil {
+		selects = append(selects, *sel)
+	} else {
+		sections = append(sections, plaintextSection(fmt.Sprintf("There are no Helm releases in the %q namespace.", details.namespace)))
+	}
+
+	if details.action == rollbackAction && details.release != "" {
+		revisions, err := e.releaseHistory(ctx, details, envs)
+		if err != nil {
+			return api.Message{}, err
+		}
+		revisionOptions := previousRevisionOptions(revisions)
+		if !containsOption(revisionOptions, details.revision) {
+			details.revision = ""
+		}
+		if sel := builderSelect("Select revision", revisionDropdownCommand, "Revisions", revisionOptions, details.revision); sel != nil {
+			selects = append(selects, *sel)
+		} else {
+			sections = append(sections, plaintextSection(fmt.Sprintf("The %q release doesn't have any previous revisions to roll back to.", details.release)))
+		}
+	}
+
+	if cmd := details.Command(); cmd != "" {
+		sections = append(sections, previewSections(cmd)...)
+	}
+
+	return api.Message{
+		ReplaceOriginal:   replaceOriginal,
+		OnlyVisibleForYou: true,
+		Sections: append([]api.Section{
+			{
+				Selects: api.Selects{
+					ID:    details.blockID,
+					Items: selects,
+				},
+			},
+		}, sections...),
+	}, nil
+}
+
+func (e *Executor) listReleases(ctx context.Context, envs map[string]string) ([]helmListItem, error) {
+	out, err := e.executeCommand(ctx, fmt.Sprintf("helm list -A -o json --max %d", dropdownItemsLimit), pluginx.ExecuteCommandEnvs(envs))
+	if err != nil {
+		return nil, fmt.Errorf("while listing Helm releases: %w", err)
+	}
+
+	var releases []helmListItem
+	if err := json.Unmarshal([]byte(out.Stdout), &releases); err != nil {
+		return nil, fmt.Errorf("while unmarshaling Helm releases: %w", err)
+	}
+	return releases, nil
+}
+
+func (e *Executor) releaseHistory(ctx context.Context, details builderState, envs map[string]string) ([]helmHistoryItem, error) {
+	cmd := fmt.Sprintf("helm history %s -n %s -o json --max %d", details.release, details.namespace, dropdownItemsLimit)
+	out, err := e.executeCommand(ctx, cmd, pluginx.ExecuteCommandEnvs(envs))
+	if err != nil {
+		return nil, fmt.Errorf("while getting history of the %q release: %w", details.release, err)
+	}
+
+	var revisions []helmHistoryItem
+	if err := json.Unmarshal([]byte(out.Stdout), &revisions); err != nil {
+		return nil, fmt.Errorf("while unmarshaling history of the %q release: %w", details.release, err)
+	}
+	return revisions, nil
+}
+
+func actionOptions() []api.OptionItem {
+	var options []api.OptionItem
+	for _, action := range builderActions {
+		options = append(options, api.OptionItem{
+			Name:  action,
+			Value: action,
+		})
+	}
+	return options
+}
+
+// namespaceOptions returns namespaces with at least one release. The selected namespace is always included.
+func namespaceOptions(releases []helmListItem, selected string) []api.OptionItem {
+	unique := map[string]struct{}{
+		selected: {},
+	}
+	for _, release := range releases {
+		unique[release.Namespace] = struct{}{}
+	}
+
+	var namespaces []string
+	for ns := range unique {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	var options []api.OptionItem
+	for _, ns := range namespaces {
+		options = append(options, api.OptionItem{
+			Name:  ns,
+			Value: ns,
+		})
+	}
+	return options
+}
+
+func releaseOptionsInNamespace(releases []helmListItem, namespace string) []api.OptionItem {
+	var options []api.OptionItem
+	for _, release := range releases {
+		if release.Namespace != namespace {
+			continue
+		}
+		options = append(options, api.OptionItem{
+			Name:  release.Name,
+			Value: release.Name,
+		})
+	}
+	return options
+}
+
+// previousRevisionOptions returns all revisions except the latest one, which is the currently deployed revision.
+func previousRevisionOptions(revisions []helmHistoryItem) []api.OptionItem {
+	var options []api.OptionItem
+	// the history is sorted from the oldest revision, show the most recent ones first.
+	for idx := len(revisions) - 2; idx >= 0; idx-- {
+		rev := revisions[idx]
+		value := fmt.Sprintf("%d", rev.Revision)
+		options = append(options, api.OptionItem{
+			Name:  fmt.Sprintf("%s: %s (%s)", value, rev.Chart, rev.Status),
+			Value: value,
+		})
+	}
+	return options
+}
+
+// builderSelect returns a dropdown for a given builder command. It returns nil if there are no options to select.
+func builderSelect(name, cmd, group string, options []api.OptionItem, selected string) *api.Select {
+	if len(options) == 0 {
+		return nil
+	}
+
+	var initial *api.OptionItem
+	for _, opt := range options {
+		if opt.Value == selected {
+			opt := opt
+			initial = &opt
+		}
+	}
+
+	return &api.Select{
+		Name:    name,
+		Command: fmt.Sprintf("%s %s %s", api.MessageBotNamePlaceholder, PluginName, cmd),
+		OptionGroups: []api.OptionGroup{
+			{
+				Name:    group,
+				Options: options,
+			},
+		},
+		InitialOption: initial,
+	}
+}
+
+func previewSections(cmd string) []api.Section {
+	btn := api.ButtonBuilder{}
+	return []api.Section{
+		{
+			Base: api.Base{
+				Body: api.Body{
+					CodeBlock: cmd,
+				},
+			},
+		},
+		{
+			Buttons: api.Buttons{
+				btn.ForCommandWithoutDesc(interactive.RunCommandName, cmd, api.ButtonStylePrimary),
+			},
+		},
+	}
+}
+
+func plaintextSection(msg string) api.Section {
+	return api.Section{
+		Base: api.Base{
+			Body: api.Body{
+				Plaintext: msg,
+			},
+		},
+	}
+}
+
+func containsOption(options []api.OptionItem, value string) bool {
+	for _, opt := range options {
+		if opt.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// builderFieldID returns the ID under which a given dropdown value is stored in the message state.
+func builderFieldID(cmd string) string {
+	return fmt.Sprintf("%s %s", PluginName, cmd)
+}
+
+func selectedValue(args, cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(args, cmd))
+}
+
+func trimPluginName(cmd string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(cmd), PluginName))
+}
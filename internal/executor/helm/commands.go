@@ -9,6 +9,11 @@ type Commands struct {
 	Rollback *RollbackCommand `arg:"subcommand:rollback"`
 	Upgrade  *UpgradeCommand  `arg:"subcommand:upgrade"`
 	Get      *GetCommand      `arg:"subcommand:get"`
+	Repo     *RepoCommand     `arg:"subcommand:repo"`
+	Search   *SearchCommand   `arg:"subcommand:search"`
+	Show     *ShowCommand     `arg:"subcommand:show"`
+	Template *TemplateCommand `arg:"subcommand:template"`
+	Diff     *DiffCommand     `arg:"subcommand:diff"`
 
 	// embed on the root of the Command struct to inline all aliases.
 	HistoryCommandAliases
@@ -0,0 +1,144 @@
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/muesli/reflow/indent"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const diffContextLines = 3
+
+var diffUpgradePrefix = regexp.MustCompile(`^\s*helm\s+diff\s+upgrade\b`)
+
+// DiffCommand holds possible diff options such as positional arguments and supported flags.
+// Syntax:
+//
+//	helm diff [command]
+type DiffCommand struct {
+	Upgrade *DiffUpgradeCommand `arg:"subcommand:upgrade"`
+}
+
+// Help returns command help message.
+func (DiffCommand) Help() string {
+	return heredoc.Doc(`
+		Shows a diff explaining what a Helm operation would change.
+
+		Usage:
+		  helm diff [command]
+
+		Available Commands:
+		  upgrade     # Shows a diff explaining what a helm upgrade would change
+
+		Use "helm diff [command] --help" for more information about the command.
+	`)
+}
+
+// DiffUpgradeCommand holds possible diff upgrade options such as positional arguments and supported flags.
+// Syntax:
+//
+//	helm diff upgrade [RELEASE] [CHART] [flags]
+type DiffUpgradeCommand struct {
+	Name  string `arg:"positional"`
+	Chart string `arg:"positional"`
+
+	SupportedDiffUpgradeFlags
+	NotSupportedUpgradeFlags
+}
+
+// Validate validates that all diff upgrade parameters are valid.
+func (d DiffUpgradeCommand) Validate() error {
+	if d.Name == "" || d.Chart == "" {
+		return errors.New("Release name and chart are required. Use 'helm diff upgrade [RELEASE] [CHART]'.")
+	}
+	if strings.HasPrefix(d.Chart, "oci://") {
+		return errors.New("Diffing Helm chart from OCI registry is not supported.")
+	}
+	return returnErrorOfAllSetFlags(d.NotSupportedUpgradeFlags)
+}
+
+// Help returns command help message.
+func (DiffUpgradeCommand) Help() string {
+	return heredoc.Docf(`
+		Shows a diff between the manifest of the live release and the manifest
+		rendered by a dry-run upgrade with the given chart and values.
+
+		It accepts the same chart and values flags as 'helm upgrade'.
+
+		Usage:
+		  helm diff upgrade [RELEASE] [CHART] [flags]
+		Flags:
+		%s
+	`, indent.String(renderSupportedFlags(SupportedDiffUpgradeFlags{}), 4))
+}
+
+// SupportedDiffUpgradeFlags represent flags that are supported both by Helm CLI and Helm Plugin.
+type SupportedDiffUpgradeFlags struct {
+	Devel                 bool     `arg:"--devel"`
+	InsecureSkipTLSVerify bool     `arg:"--insecure-skip-tls-verify"`
+	NoHooks               bool     `arg:"--no-hooks"`
+	PassCredentials       bool     `arg:"--pass-credentials"`
+	Password              string   `arg:"--password"`
+	Repo                  string   `arg:"--repo"`
+	ResetValues           bool     `arg:"--reset-values"`
+	ReuseValues           bool     `arg:"--reuse-values"`
+	Set                   []string `arg:"--set"`
+	SetJSON               []string `arg:"--set-json"`
+	SetString             []string `arg:"--set-string"`
+	SkipCRDs              bool     `arg:"--skip-crds"`
+	Username              string   `arg:"--username"`
+	Version               string   `arg:"--version"`
+}
+
+// helmRelease represents the release details printed by Helm CLI in the JSON format.
+type helmRelease struct {
+	Manifest string `json:"manifest"`
+}
+
+// diffUpgrade compares the live release manifest with the manifest rendered by a dry-run upgrade.
+func (e *Executor) diffUpgrade(ctx context.Context, cmd *DiffUpgradeCommand, rawCmd, namespace string, envs map[string]string) (string, error) {
+	live, err := e.executeCommand(ctx, fmt.Sprintf("helm get manifest %s -n %s", cmd.Name, namespace), pluginx.ExecuteCommandEnvs(envs))
+	if err != nil {
+		return "", fmt.Errorf("while getting manifest of the %q release: %w", cmd.Name, err)
+	}
+
+	upgradeCmd := diffUpgradePrefix.ReplaceAllString(rawCmd, "helm upgrade")
+	out, err := e.executeCommand(ctx, fmt.Sprintf("%s --dry-run -o json", upgradeCmd), pluginx.ExecuteCommandEnvs(envs))
+	if err != nil {
+		return "", fmt.Errorf("while running dry-run upgrade of the %q release: %w", cmd.Name, err)
+	}
+
+	var upgraded helmRelease
+	if err := json.Unmarshal([]byte(out.Stdout), &upgraded); err != nil {
+		return "", fmt.Errorf("while unmarshaling dry-run upgrade output: %w", err)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitManifestLines(live.Stdout),
+		B:        splitManifestLines(upgraded.Manifest),
+		FromFile: fmt.Sprintf("%s (live)", cmd.Name),
+		ToFile:   fmt.Sprintf("%s (upgrade)", cmd.Name),
+		Context:  diffContextLines,
+	})
+	if err != nil {
+		return "", fmt.Errorf("while computing diff: %w", err)
+	}
+
+	if strings.TrimSpace(diff) == "" {
+		return fmt.Sprintf("No changes detected for the %q release.", cmd.Name), nil
+	}
+	return diff, nil
+}
+
+// splitManifestLines splits a given manifest into lines. Unlike difflib.SplitLines, it doesn't add an empty line for the trailing newline.
+func splitManifestLines(in string) []string {
+	return difflib.SplitLines(strings.TrimSuffix(in, "\n"))
+}
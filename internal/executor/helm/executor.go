@@ -8,6 +8,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/alexflint/go-arg"
 
+	"github.com/kubeshop/botkube/internal/executor/x/state"
 	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/executor"
 	"github.com/kubeshop/botkube/pkg/pluginx"
@@ -83,6 +84,13 @@ func (e *Executor) Metadata(context.Context) (api.MetadataOutput, error) {
 // - upgrade
 // - history
 // - get [all|manifest|hooks|notes]
+// - repo [add|list|update]
+// - search repo
+// - show [all|chart|readme|values]
+// - template
+// - diff upgrade
+//
+// If interactivity is supported, running 'helm' without a command opens the interactive command builder.
 func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (executor.ExecuteOutput, error) {
 	if err := pluginx.ValidateKubeConfigProvided(PluginName, in.Context.KubeConfig); err != nil {
 		return executor.ExecuteOutput{}, err
@@ -93,6 +101,25 @@ func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (execu
 		return executor.ExecuteOutput{}, fmt.Errorf("while merging input configs: %w", err)
 	}
 
+	kubeConfigPath, deleteFn, err := pluginx.PersistKubeConfig(ctx, in.Context.KubeConfig)
+	if err != nil {
+		return executor.ExecuteOutput{}, fmt.Errorf("while writing kubeconfig file: %w", err)
+	}
+	defer func() {
+		if deleteErr := deleteFn(ctx); deleteErr != nil {
+			fmt.Fprintf(os.Stderr, "failed to delete kubeconfig file %s: %v", kubeConfigPath, deleteErr)
+		}
+	}()
+
+	if in.Context.IsInteractivitySupported && isBuilderCommand(in.Command) {
+		details := extractBuilderState(in.Command, state.ExtractSlackState(in.Context.SlackState))
+		msg, err := e.handleBuilder(ctx, details, cfg.DefaultNamespace, helmEnvs(cfg, kubeConfigPath))
+		if err != nil {
+			return executor.ExecuteOutput{}, err
+		}
+		return executor.ExecuteOutput{Message: msg}, nil
+	}
+
 	var wasHelpRequested bool
 	var helmCmd Commands
 	err = pluginx.ParseCommand(PluginName, in.Command, &helmCmd)
@@ -109,16 +136,6 @@ func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (execu
 		in.Command = fmt.Sprintf("%s -n %s", in.Command, cfg.DefaultNamespace)
 	}
 
-	kubeConfigPath, deleteFn, err := pluginx.PersistKubeConfig(ctx, in.Context.KubeConfig)
-	if err != nil {
-		return executor.ExecuteOutput{}, fmt.Errorf("while writing kubeconfig file: %w", err)
-	}
-	defer func() {
-		if deleteErr := deleteFn(ctx); deleteErr != nil {
-			fmt.Fprintf(os.Stderr, "failed to delete kubeconfig file %s: %v", kubeConfigPath, deleteErr)
-		}
-	}()
-
 	switch {
 	case helmCmd.Install != nil:
 		return e.handleHelmCommand(ctx, helmCmd.Install, cfg, wasHelpRequested, in.Command, kubeConfigPath)
@@ -133,6 +150,14 @@ func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (execu
 	case helmCmd.Test != nil:
 		return e.handleHelmCommand(ctx, helmCmd.Test, cfg, wasHelpRequested, in.Command, kubeConfigPath)
 	case helmCmd.Rollback != nil:
+		if helmCmd.Rollback.Name == "" && in.Context.IsInteractivitySupported && !wasHelpRequested {
+			details := builderState{action: rollbackAction, namespace: helmCmd.Namespace}
+			msg, err := e.handleBuilder(ctx, details, cfg.DefaultNamespace, helmEnvs(cfg, kubeConfigPath))
+			if err != nil {
+				return executor.ExecuteOutput{}, err
+			}
+			return executor.ExecuteOutput{Message: msg}, nil
+		}
 		return e.handleHelmCommand(ctx, helmCmd.Rollback, cfg, wasHelpRequested, in.Command, kubeConfigPath)
 	case helmCmd.Upgrade != nil:
 		return e.handleHelmCommand(ctx, helmCmd.Upgrade, cfg, wasHelpRequested, in.Command, kubeConfigPath)
@@ -155,6 +180,54 @@ func (e *Executor) Execute(ctx context.Context, in executor.ExecuteInput) (execu
 				Message: api.NewCodeBlockMessage(helmCmd.Get.Help(), true),
 			}, nil
 		}
+	case helmCmd.Repo != nil:
+		switch {
+		case helmCmd.Repo.Add != nil:
+			return e.handleHelmCommand(ctx, helmCmd.Repo.Add, cfg, wasHelpRequested, in.Command, kubeConfigPath)
+		case helmCmd.Repo.GetList() != nil:
+			return e.handleHelmCommand(ctx, helmCmd.Repo.GetList(), cfg, wasHelpRequested, in.Command, kubeConfigPath)
+		case helmCmd.Repo.GetUpdate() != nil:
+			return e.handleHelmCommand(ctx, helmCmd.Repo.GetUpdate(), cfg, wasHelpRequested, in.Command, kubeConfigPath)
+		default:
+			return executor.ExecuteOutput{
+				Message: api.NewCodeBlockMessage(helmCmd.Repo.Help(), true),
+			}, nil
+		}
+	case helmCmd.Search != nil:
+		if helmCmd.Search.Repo == nil {
+			return executor.ExecuteOutput{
+				Message: api.NewCodeBlockMessage(helmCmd.Search.Help(), true),
+			}, nil
+		}
+		return e.handleHelmCommand(ctx, helmCmd.Search.Repo, cfg, wasHelpRequested, in.Command, kubeConfigPath)
+	case helmCmd.Show != nil:
+		switch {
+		case helmCmd.Show.All != nil:
+			return e.handleHelmCommand(ctx, helmCmd.Show.All, cfg, wasHelpRequested, in.Command, kubeConfigPath)
+		case helmCmd.Show.Chart != nil:
+			return e.handleHelmCommand(ctx, helmCmd.Show.Chart, cfg, wasHelpRequested, in.Command, kubeConfigPath)
+		case helmCmd.Show.Readme != nil:
+			return e.handleHelmCommand(ctx, helmCmd.Show.Readme, cfg, wasHelpRequested, in.Command, kubeConfigPath)
+		case helmCmd.Show.Values != nil:
+			return e.handleHelmCommand(ctx, helmCmd.Show.Values, cfg, wasHelpRequested, in.Command, kubeConfigPath)
+		default:
+			return executor.ExecuteOutput{
+				Message: api.NewCodeBlockMessage(helmCmd.Show.Help(), true),
+			}, nil
+		}
+	case helmCmd.Template != nil:
+		return e.handleHelmCommand(ctx, helmCmd.Template, cfg, wasHelpRequested, in.Command, kubeConfigPath)
+	case helmCmd.Diff != nil:
+		if helmCmd.Diff.Upgrade == nil {
+			return executor.ExecuteOutput{
+				Message: api.NewCodeBlockMessage(helmCmd.Diff.Help(), true),
+			}, nil
+		}
+		namespace := helmCmd.Namespace
+		if namespace == "" {
+			namespace = cfg.DefaultNamespace
+		}
+		return e.handleDiffUpgradeCommand(ctx, helmCmd.Diff.Upgrade, cfg, wasHelpRequested, in.Command, namespace, kubeConfigPath)
 	default:
 		return executor.ExecuteOutput{
 			Message: api.NewCodeBlockMessage("Helm command not supported", true),
@@ -180,23 +253,47 @@ func (e *Executor) handleHelmCommand(ctx context.Context, cmd command, cfg Confi
 		return executor.ExecuteOutput{}, err
 	}
 
-	envs := map[string]string{
-		"HELM_DRIVER":      cfg.HelmDriver,
-		"HELM_CACHE_HOME":  cfg.HelmCacheDir,
-		"HELM_CONFIG_HOME": cfg.HelmConfigDir,
-		"KUBECONFIG":       kubeConfig,
+	out, err := e.executeCommand(ctx, rawCmd, pluginx.ExecuteCommandEnvs(helmEnvs(cfg, kubeConfig)))
+	if err != nil {
+		return executor.ExecuteOutput{}, err
 	}
 
-	out, err := e.executeCommand(ctx, rawCmd, pluginx.ExecuteCommandEnvs(envs))
+	return executor.ExecuteOutput{
+		Message: api.NewCodeBlockMessage(out.Stdout, true),
+	}, nil
+}
+
+// handleDiffUpgradeCommand shows a diff between the live release and a dry-run upgrade.
+func (e *Executor) handleDiffUpgradeCommand(ctx context.Context, cmd *DiffUpgradeCommand, cfg Config, wasHelpRequested bool, rawCmd, namespace, kubeConfig string) (executor.ExecuteOutput, error) {
+	if wasHelpRequested {
+		return executor.ExecuteOutput{
+			Message: api.NewCodeBlockMessage(cmd.Help(), true),
+		}, nil
+	}
+
+	if err := cmd.Validate(); err != nil {
+		return executor.ExecuteOutput{}, err
+	}
+
+	diff, err := e.diffUpgrade(ctx, cmd, rawCmd, namespace, helmEnvs(cfg, kubeConfig))
 	if err != nil {
 		return executor.ExecuteOutput{}, err
 	}
 
 	return executor.ExecuteOutput{
-		Message: api.NewCodeBlockMessage(out.Stdout, true),
+		Message: api.NewCodeBlockMessage(diff, true),
 	}, nil
 }
 
+func helmEnvs(cfg Config, kubeConfig string) map[string]string {
+	return map[string]string{
+		"HELM_DRIVER":      cfg.HelmDriver,
+		"HELM_CACHE_HOME":  cfg.HelmCacheDir,
+		"HELM_CONFIG_HOME": cfg.HelmConfigDir,
+		"KUBECONFIG":       kubeConfig,
+	}
+}
+
 // jsonSchema returns JSON schema for the executor.
 // helmCacheDir and helmConfigDir were skipped as the options are not user-facing.
 func jsonSchema() api.JSONSchema {
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
+	"github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
@@ -182,6 +184,363 @@ func TestExecutorHelmInstallHelp(t *testing.T) {
 	}
 }
 
+func TestExecutorHelmRepoSearchShowAndTemplate(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCommand string
+		expCommand   string
+	}{
+		{
+			name:         "add chart repository",
+			inputCommand: "helm repo add bitnami https://charts.bitnami.com/bitnami --force-update",
+			expCommand:   "helm repo add bitnami https://charts.bitnami.com/bitnami --force-update -n default",
+		},
+		{
+			name:         "list chart repositories by alias",
+			inputCommand: "helm repo ls -o json",
+			expCommand:   "helm repo ls -o json -n default",
+		},
+		{
+			name:         "update chart repositories",
+			inputCommand: "helm repo update",
+			expCommand:   "helm repo update -n default",
+		},
+		{
+			name:         "search repositories",
+			inputCommand: "helm search repo postgresql --versions",
+			expCommand:   "helm search repo postgresql --versions -n default",
+		},
+		{
+			name:         "show chart values",
+			inputCommand: "helm show values bitnami/postgresql --version 12.1.0",
+			expCommand:   "helm show values bitnami/postgresql --version 12.1.0 -n default",
+		},
+		{
+			name:         "render chart templates",
+			inputCommand: "helm template psql bitnami/postgresql -n db --set auth.database=app -s templates/primary/statefulset.yaml",
+			expCommand:   "helm template psql bitnami/postgresql -n db --set auth.database=app -s templates/primary/statefulset.yaml",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			hExec := NewExecutor("testing")
+
+			var gotCmd string
+			hExec.executeCommand = func(ctx context.Context, rawCmd string, mutators ...pluginx.ExecuteCommandMutation) (pluginx.ExecuteCommandOutput, error) {
+				gotCmd = rawCmd
+				return pluginx.ExecuteCommandOutput{Stdout: "mocked"}, nil
+			}
+
+			// when
+			out, err := hExec.Execute(context.Background(), executor.ExecuteInput{
+				Command: tc.inputCommand,
+				Context: executor.ExecuteInputContext{
+					KubeConfig: []byte("not empty"),
+				},
+			})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, api.NewCodeBlockMessage("mocked", true), out.Message)
+			assert.Equal(t, tc.expCommand, gotCmd)
+		})
+	}
+}
+
+func TestExecutorHelmRepoSearchShowAndTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCommand string
+		expErrMsg    string
+	}{
+		{
+			name:         "report not supported repo add flag",
+			inputCommand: "helm repo add bitnami https://charts.bitnami.com/bitnami --ca-file ca.crt",
+			expErrMsg:    `The "--ca-file" flag is not supported by the Botkube Helm plugin. Please remove it.`,
+		},
+		{
+			name:         "show chart from OCI registry",
+			inputCommand: "helm show chart oci://example.com/charts/nginx",
+			expErrMsg:    "Showing Helm chart from OCI registry is not supported.",
+		},
+		{
+			name:         "render templates to output directory",
+			inputCommand: "helm template psql bitnami/postgresql --output-dir /tmp/out",
+			expErrMsg:    `The "--output-dir" flag is not supported by the Botkube Helm plugin. Please remove it.`,
+		},
+		{
+			name:         "diff upgrade without chart",
+			inputCommand: "helm diff upgrade psql",
+			expErrMsg:    "Release name and chart are required. Use 'helm diff upgrade [RELEASE] [CHART]'.",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			hExec := NewExecutor("testing")
+			hExec.executeCommand = noopExecuteCommand
+
+			// when
+			out, err := hExec.Execute(context.Background(), executor.ExecuteInput{
+				Command: tc.inputCommand,
+				Context: executor.ExecuteInputContext{
+					KubeConfig: []byte("not empty"),
+				},
+			})
+
+			// then
+			require.EqualError(t, err, tc.expErrMsg)
+			assert.Empty(t, out.Message)
+		})
+	}
+}
+
+func TestExecutorHelmDiffUpgrade(t *testing.T) {
+	// given
+	hExec := NewExecutor("testing")
+
+	var gotCmds []string
+	hExec.executeCommand = func(ctx context.Context, rawCmd string, mutators ...pluginx.ExecuteCommandMutation) (pluginx.ExecuteCommandOutput, error) {
+		gotCmds = append(gotCmds, rawCmd)
+		switch rawCmd {
+		case "helm get manifest psql -n db":
+			return pluginx.ExecuteCommandOutput{Stdout: "kind: StatefulSet\nspec:\n  replicas: 1\n"}, nil
+		case "helm upgrade psql bitnami/postgresql --set replicas=2 -n db --dry-run -o json":
+			return pluginx.ExecuteCommandOutput{Stdout: `{"name": "psql", "manifest": "kind: StatefulSet\nspec:\n  replicas: 2\n"}`}, nil
+		default:
+			return pluginx.ExecuteCommandOutput{}, fmt.Errorf("unexpected command %q", rawCmd)
+		}
+	}
+
+	// when
+	out, err := hExec.Execute(context.Background(), executor.ExecuteInput{
+		Command: "helm diff upgrade psql bitnami/postgresql --set replicas=2 -n db",
+		Context: executor.ExecuteInputContext{
+			KubeConfig: []byte("not empty"),
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, gotCmds, 2)
+	assert.Equal(t, api.NewCodeBlockMessage(heredoc.Doc(`
+		--- psql (live)
+		+++ psql (upgrade)
+		@@ -1,3 +1,3 @@
+		 kind: StatefulSet
+		 spec:
+		-  replicas: 1
+		+  replicas: 2
+	`), true), out.Message)
+}
+
+func TestExecutorHelmRollbackBuilder(t *testing.T) {
+	// given
+	hExec := NewExecutor("testing")
+	hExec.executeCommand = func(ctx context.Context, rawCmd string, mutators ...pluginx.ExecuteCommandMutation) (pluginx.ExecuteCommandOutput, error) {
+		switch rawCmd {
+		case "helm list -A -o json --max 100":
+			return pluginx.ExecuteCommandOutput{Stdout: `[{"name": "psql", "namespace": "db", "chart": "postgresql-12.1.0", "status": "deployed"}]`}, nil
+		case "helm history psql -n db -o json --max 100":
+			return pluginx.ExecuteCommandOutput{Stdout: `[
+				{"revision": 1, "chart": "postgresql-12.0.0", "status": "superseded"},
+				{"revision": 2, "chart": "postgresql-12.0.1", "status": "superseded"},
+				{"revision": 3, "chart": "postgresql-12.1.0", "status": "deployed"}
+			]`}, nil
+		default:
+			return pluginx.ExecuteCommandOutput{}, fmt.Errorf("unexpected command %q", rawCmd)
+		}
+	}
+	execute := func(cmd string, selected map[string]string) api.Message {
+		var slackState *slack.BlockActionStates
+		if selected != nil {
+			actions := map[string]slack.BlockAction{}
+			for id, val := range selected {
+				actions[id] = slack.BlockAction{SelectedOption: slack.OptionBlockObject{Value: val}}
+			}
+			slackState = &slack.BlockActionStates{
+				Values: map[string]map[string]slack.BlockAction{"dropdowns": actions},
+			}
+		}
+		out, err := hExec.Execute(context.Background(), executor.ExecuteInput{
+			Command: cmd,
+			Context: executor.ExecuteInputContext{
+				KubeConfig:               []byte("not empty"),
+				IsInteractivitySupported: true,
+				SlackState:               slackState,
+			},
+		})
+		require.NoError(t, err)
+		return out.Message
+	}
+	findSelect := func(msg api.Message, cmd string) *api.Select {
+		for _, sel := range msg.Sections[0].Selects.Items {
+			if sel.Command == fmt.Sprintf("{{BotName}} helm %s", cmd) {
+				sel := sel
+				return &sel
+			}
+		}
+		return nil
+	}
+
+	// when
+	initial := execute("helm rollback -n db", nil)
+
+	// then
+	assert.False(t, initial.ReplaceOriginal)
+	require.Len(t, initial.Sections, 1)
+	assert.Equal(t, "rollback", findSelect(initial, "@builder --action").InitialOption.Value)
+	assert.Equal(t, "db", findSelect(initial, "@builder --namespace").InitialOption.Value)
+	releases := findSelect(initial, "@builder --release")
+	require.NotNil(t, releases)
+	assert.Nil(t, releases.InitialOption)
+	assert.Equal(t, []api.OptionItem{{Name: "psql", Value: "psql"}}, releases.OptionGroups[0].Options)
+	assert.Nil(t, findSelect(initial, "@builder --revision"))
+
+	// when
+	withRelease := execute("helm @builder --release psql", map[string]string{
+		"helm @builder --action":    "rollback",
+		"helm @builder --namespace": "db",
+	})
+
+	// then
+	assert.True(t, withRelease.ReplaceOriginal)
+	require.Len(t, withRelease.Sections, 1, "preview should be hidden until the revision is selected")
+	revisions := findSelect(withRelease, "@builder --revision")
+	require.NotNil(t, revisions)
+	assert.Equal(t, []api.OptionItem{
+		{Name: "2: postgresql-12.0.1 (superseded)", Value: "2"},
+		{Name: "1: postgresql-12.0.0 (superseded)", Value: "1"},
+	}, revisions.OptionGroups[0].Options)
+
+	// when
+	withRevision := execute("helm @builder --revision 2", map[string]string{
+		"helm @builder --action":    "rollback",
+		"helm @builder --namespace": "db",
+		"helm @builder --release":   "psql",
+	})
+
+	// then
+	require.Len(t, withRevision.Sections, 3)
+	assert.Equal(t, "2", findSelect(withRevision, "@builder --revision").InitialOption.Value)
+	assert.Equal(t, "helm rollback psql 2 -n db", withRevision.Sections[1].Body.CodeBlock)
+	assert.Equal(t, "{{BotName}} helm rollback psql 2 -n db", withRevision.Sections[2].Buttons[0].Command)
+}
+
+func TestExecutorHelmBuilder(t *testing.T) {
+	// given
+	hExec := NewExecutor("testing")
+	hExec.executeCommand = func(ctx context.Context, rawCmd string, mutators ...pluginx.ExecuteCommandMutation) (pluginx.ExecuteCommandOutput, error) {
+		switch rawCmd {
+		case "helm list -A -o json --max 100":
+			return pluginx.ExecuteCommandOutput{Stdout: `[
+				{"name": "psql", "namespace": "db", "chart": "postgresql-12.1.0", "status": "deployed"},
+				{"name": "nginx", "namespace": "default", "chart": "nginx-15.0.0", "status": "deployed"}
+			]`}, nil
+		case "helm history psql -n db -o json --max 100":
+			return pluginx.ExecuteCommandOutput{Stdout: `[
+				{"revision": 1, "chart": "postgresql-12.0.0", "status": "superseded"},
+				{"revision": 2, "chart": "postgresql-12.0.1", "status": "superseded"},
+				{"revision": 3, "chart": "postgresql-12.1.0", "status": "deployed"}
+			]`}, nil
+		default:
+			return pluginx.ExecuteCommandOutput{}, fmt.Errorf("unexpected command %q", rawCmd)
+		}
+	}
+	execute := func(cmd string, selected map[string]string) api.Message {
+		var slackState *slack.BlockActionStates
+		if selected != nil {
+			actions := map[string]slack.BlockAction{}
+			for id, val := range selected {
+				actions[id] = slack.BlockAction{SelectedOption: slack.OptionBlockObject{Value: val}}
+			}
+			slackState = &slack.BlockActionStates{
+				Values: map[string]map[string]slack.BlockAction{"dropdowns": actions},
+			}
+		}
+		out, err := hExec.Execute(context.Background(), executor.ExecuteInput{
+			Command: cmd,
+			Context: executor.ExecuteInputContext{
+				KubeConfig:               []byte("not empty"),
+				IsInteractivitySupported: true,
+				SlackState:               slackState,
+			},
+		})
+		require.NoError(t, err)
+		return out.Message
+	}
+
+	// when
+	initial := execute("helm", nil)
+
+	// then
+	assert.False(t, initial.ReplaceOriginal)
+	assert.True(t, initial.OnlyVisibleForYou)
+	require.Len(t, initial.Sections, 1)
+	require.Len(t, initial.Sections[0].Selects.Items, 3)
+	actions, namespaces, releases := initial.Sections[0].Selects.Items[0], initial.Sections[0].Selects.Items[1], initial.Sections[0].Selects.Items[2]
+	assert.Equal(t, "{{BotName}} helm @builder --action", actions.Command)
+	assert.Nil(t, actions.InitialOption)
+	assert.Equal(t, "{{BotName}} helm @builder --namespace", namespaces.Command)
+	assert.Equal(t, []api.OptionItem{{Name: "db", Value: "db"}, {Name: "default", Value: "default"}}, namespaces.OptionGroups[0].Options)
+	assert.Equal(t, "default", namespaces.InitialOption.Value)
+	assert.Equal(t, "{{BotName}} helm @builder --release", releases.Command)
+	assert.Equal(t, []api.OptionItem{{Name: "nginx", Value: "nginx"}}, releases.OptionGroups[0].Options)
+
+	// when
+	withNamespace := execute("helm @builder --namespace db", map[string]string{
+		"helm @builder --action":  "status",
+		"helm @builder --release": "nginx",
+	})
+
+	// then
+	assert.True(t, withNamespace.ReplaceOriginal)
+	assert.Equal(t, "dropdowns", withNamespace.Sections[0].Selects.ID)
+	require.Len(t, withNamespace.Sections, 1, "release from the previous namespace should be cleared")
+	assert.Equal(t, []api.OptionItem{{Name: "psql", Value: "psql"}}, withNamespace.Sections[0].Selects.Items[2].OptionGroups[0].Options)
+
+	// when
+	withRelease := execute("helm @builder --release psql", map[string]string{
+		"helm @builder --action":    "status",
+		"helm @builder --namespace": "db",
+	})
+
+	// then
+	require.Len(t, withRelease.Sections, 3)
+	assert.Equal(t, "helm status psql -n db", withRelease.Sections[1].Body.CodeBlock)
+	assert.Equal(t, "{{BotName}} helm status psql -n db", withRelease.Sections[2].Buttons[0].Command)
+
+	// when
+	withRollback := execute("helm @builder --action rollback", map[string]string{
+		"helm @builder --namespace": "db",
+		"helm @builder --release":   "psql",
+	})
+
+	// then
+	require.Len(t, withRollback.Sections, 1, "preview should be hidden until the revision is selected")
+	require.Len(t, withRollback.Sections[0].Selects.Items, 4)
+	revisions := withRollback.Sections[0].Selects.Items[3]
+	assert.Equal(t, "{{BotName}} helm @builder --revision", revisions.Command)
+	assert.Equal(t, []api.OptionItem{
+		{Name: "2: postgresql-12.0.1 (superseded)", Value: "2"},
+		{Name: "1: postgresql-12.0.0 (superseded)", Value: "1"},
+	}, revisions.OptionGroups[0].Options)
+
+	// when
+	withRevision := execute("helm @builder --revision 2", map[string]string{
+		"helm @builder --action":    "rollback",
+		"helm @builder --namespace": "db",
+		"helm @builder --release":   "psql",
+	})
+
+	// then
+	require.Len(t, withRevision.Sections, 3)
+	assert.Equal(t, "2", withRevision.Sections[0].Selects.Items[3].InitialOption.Value)
+	assert.Equal(t, "helm rollback psql 2 -n db", withRevision.Sections[1].Body.CodeBlock)
+	assert.Equal(t, "{{BotName}} helm rollback psql 2 -n db", withRevision.Sections[2].Buttons[0].Command)
+}
+
 func TestExecutorConfigMerging(t *testing.T) {
 	// given
 	hExec := NewExecutor("testing")
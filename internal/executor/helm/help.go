@@ -24,10 +24,16 @@ func help() string {
 		  version     # Shows the version of the Helm CLI used by this Botkube plugin.
 		  history     # Shows release history
 		  get         # Shows extended information of a named release
+		  repo        # Adds, lists and updates chart repositories
+		  search      # Searches for a keyword in charts
+		  show        # Shows information of a chart
+		  template    # Renders chart templates locally and displays the output
+		  diff        # Shows a diff explaining what a helm upgrade would change
 
 		Flags:
 		%s
 
 		Use "helm [command] --help" for more information about the command.
+		Run "helm" without arguments to build a command interactively, or "helm rollback" to select a release and revision to roll back to.
 	`, indent.String(renderSupportedFlags(GlobalFlags{}), 4))
 }
@@ -0,0 +1,158 @@
+package helm
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/muesli/reflow/indent"
+)
+
+// RepoCommand holds possible repo options such as positional arguments and supported flags.
+// Syntax:
+//
+//	helm repo [command]
+type RepoCommand struct {
+	Add    *RepoAddCommand    `arg:"subcommand:add"`
+	List   *RepoListCommand   `arg:"subcommand:list"`
+	Ls     *RepoListCommand   `arg:"subcommand:ls"`
+	Update *RepoUpdateCommand `arg:"subcommand:update"`
+	Up     *RepoUpdateCommand `arg:"subcommand:up"`
+}
+
+// GetList returns RepoListCommand that were unpacked based on the alias used by user.
+func (r RepoCommand) GetList() *RepoListCommand {
+	if r.List != nil {
+		return r.List
+	}
+	if r.Ls != nil {
+		return r.Ls
+	}
+
+	return nil
+}
+
+// GetUpdate returns RepoUpdateCommand that were unpacked based on the alias used by user.
+func (r RepoCommand) GetUpdate() *RepoUpdateCommand {
+	if r.Update != nil {
+		return r.Update
+	}
+	if r.Up != nil {
+		return r.Up
+	}
+
+	return nil
+}
+
+// Help returns command help message.
+func (RepoCommand) Help() string {
+	return heredoc.Doc(`
+		This command consists of multiple subcommands to interact with chart repositories.
+
+		It can be used to add, list and update chart repositories.
+
+		Usage:
+		  helm repo [command]
+
+		Available Commands:
+		  add         # Adds a chart repository
+		  list        # Lists chart repositories
+		  update      # Updates information of available charts locally from chart repositories
+
+		Use "helm repo [command] --help" for more information about the command.
+	`)
+}
+
+// RepoAddCommand holds possible repo add options such as positional arguments and supported flags.
+type RepoAddCommand struct {
+	Name string `arg:"positional"`
+	URL  string `arg:"positional"`
+
+	SupportedRepoAddFlags
+	NotSupportedRepoAddFlags
+}
+
+// Validate validates that all repo add parameters are valid.
+func (r RepoAddCommand) Validate() error {
+	if strings.HasPrefix(r.URL, "oci://") {
+		return errors.New("Adding OCI registry as a chart repository is not supported.")
+	}
+	return returnErrorOfAllSetFlags(r.NotSupportedRepoAddFlags)
+}
+
+// Help returns command help message.
+func (RepoAddCommand) Help() string {
+	return heredoc.Docf(`
+		Adds a chart repository.
+
+		Usage:
+		  helm repo add [NAME] [URL] [flags]
+
+		Flags:
+		%s
+	`, indent.String(renderSupportedFlags(SupportedRepoAddFlags{}), 4))
+}
+
+// SupportedRepoAddFlags represent flags that are supported both by Helm CLI and Helm Plugin.
+type SupportedRepoAddFlags struct {
+	ForceUpdate           bool   `arg:"--force-update"`
+	InsecureSkipTLSVerify bool   `arg:"--insecure-skip-tls-verify"`
+	PassCredentials       bool   `arg:"--pass-credentials"`
+	Password              string `arg:"--password"`
+	Username              string `arg:"--username"`
+}
+
+// NotSupportedRepoAddFlags represents flags supported by Helm CLI but not by Helm Plugin.
+type NotSupportedRepoAddFlags struct {
+	CaFile        string `arg:"--ca-file"`
+	CertFile      string `arg:"--cert-file"`
+	KeyFile       string `arg:"--key-file"`
+	PasswordStdin bool   `arg:"--password-stdin"`
+}
+
+// RepoListCommand holds possible repo list options such as positional arguments and supported flags.
+type RepoListCommand struct {
+	noopValidator
+
+	SupportedRepoListFlags
+}
+
+// Help returns command help message.
+func (RepoListCommand) Help() string {
+	return heredoc.Docf(`
+		Lists chart repositories.
+
+		Usage:
+		  helm repo list [flags]
+
+		Aliases:
+		  list, ls
+
+		Flags:
+		%s
+	`, indent.String(renderSupportedFlags(SupportedRepoListFlags{}), 4))
+}
+
+// SupportedRepoListFlags represent flags that are supported both by Helm CLI and Helm Plugin.
+type SupportedRepoListFlags struct {
+	Output string `arg:"-o,--output"`
+}
+
+// RepoUpdateCommand holds possible repo update options such as positional arguments and supported flags.
+type RepoUpdateCommand struct {
+	noopValidator
+}
+
+// Help returns command help message.
+func (RepoUpdateCommand) Help() string {
+	return heredoc.Doc(`
+		Updates gets the latest information about charts from the respective chart repositories.
+		Information is cached locally, where it is used by commands like 'helm search'.
+
+		Usage:
+		  helm repo update [flags]
+
+		Aliases:
+		  update, up
+	`)
+}
@@ -0,0 +1,73 @@
+package helm
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/muesli/reflow/indent"
+)
+
+// SearchCommand holds possible search options such as positional arguments and supported flags.
+// Syntax:
+//
+//	helm search [command]
+type SearchCommand struct {
+	Repo *SearchRepoCommand `arg:"subcommand:repo"`
+}
+
+// Help returns command help message.
+func (SearchCommand) Help() string {
+	return heredoc.Doc(`
+		Search provides the ability to search for Helm charts in the various places
+		they can be stored.
+
+		Usage:
+		  helm search [command]
+
+		Available Commands:
+		  repo        # Searches repositories for a keyword in charts
+
+		Use "helm search [command] --help" for more information about the command.
+	`)
+}
+
+// SearchRepoCommand holds possible search repo options such as positional arguments and supported flags.
+// Syntax:
+//
+//	helm search repo [keyword] [flags]
+type SearchRepoCommand struct {
+	noopValidator
+
+	Keyword string `arg:"positional"`
+
+	SupportedSearchRepoFlags
+}
+
+// Help returns command help message.
+func (SearchRepoCommand) Help() string {
+	return heredoc.Docf(`
+		Searches reads through all of the repositories configured on the system, and
+		looks for matches. Search of these repositories uses the metadata stored on
+		the system.
+
+		It will display the latest stable versions of the charts found. If you
+		specify the '--devel' flag, the output will include pre-release versions.
+		If you want to search using a version constraint, use '--version'.
+
+		Repositories are managed with 'helm repo' commands.
+
+		Usage:
+		  helm search repo [keyword] [flags]
+
+		Flags:
+		%s
+	`, indent.String(renderSupportedFlags(SupportedSearchRepoFlags{}), 4))
+}
+
+// SupportedSearchRepoFlags represent flags that are supported both by Helm CLI and Helm Plugin.
+type SupportedSearchRepoFlags struct {
+	Devel       bool   `arg:"--devel"`
+	MaxColWidth uint   `arg:"--max-col-width"`
+	Output      string `arg:"-o,--output"`
+	Regexp      bool   `arg:"-r,--regexp"`
+	Version     string `arg:"--version"`
+	Versions    bool   `arg:"-l,--versions"`
+}
@@ -0,0 +1,161 @@
+package helm
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/muesli/reflow/indent"
+)
+
+// ShowCommand holds possible show options such as positional arguments and supported flags.
+// Syntax:
+//
+//	helm show [command]
+type ShowCommand struct {
+	All    *ShowAllCommand    `arg:"subcommand:all"`
+	Chart  *ShowChartCommand  `arg:"subcommand:chart"`
+	Readme *ShowReadmeCommand `arg:"subcommand:readme"`
+	Values *ShowValuesCommand `arg:"subcommand:values"`
+}
+
+// Help returns command help message.
+func (ShowCommand) Help() string {
+	return heredoc.Doc(`
+		This command consists of multiple subcommands to display information about a chart.
+
+		Usage:
+		  helm show [command]
+
+		Available Commands:
+		  all         # Shows all information of the chart
+		  chart       # Shows the chart's definition
+		  readme      # Shows the chart's README
+		  values      # Shows the chart's values
+
+		Use "helm show [command] --help" for more information about the command.
+	`)
+}
+
+// ShowChartArgs holds the chart positional argument and flags shared by all show subcommands.
+type ShowChartArgs struct {
+	Chart string `arg:"positional"`
+
+	SupportedShowFlags
+	NotSupportedShowFlags
+}
+
+// Validate validates that all show parameters are valid.
+func (s ShowChartArgs) Validate() error {
+	if strings.HasPrefix(s.Chart, "oci://") {
+		return errors.New("Showing Helm chart from OCI registry is not supported.")
+	}
+	return returnErrorOfAllSetFlags(s.NotSupportedShowFlags)
+}
+
+// SupportedShowFlags represent flags that are supported both by Helm CLI and Helm Plugin.
+type SupportedShowFlags struct {
+	Devel                 bool   `arg:"--devel"`
+	InsecureSkipTLSVerify bool   `arg:"--insecure-skip-tls-verify"`
+	PassCredentials       bool   `arg:"--pass-credentials"`
+	Password              string `arg:"--password"`
+	Repo                  string `arg:"--repo"`
+	Username              string `arg:"--username"`
+	Verify                bool   `arg:"--verify"`
+	Version               string `arg:"--version"`
+}
+
+// NotSupportedShowFlags represents flags supported by Helm CLI but not by Helm Plugin.
+type NotSupportedShowFlags struct {
+	CaFile   string `arg:"--ca-file"`
+	CertFile string `arg:"--cert-file"`
+	KeyFile  string `arg:"--key-file"`
+	Keyring  string `arg:"--keyring"`
+}
+
+// ShowAllCommand holds possible show all options such as positional arguments and supported flags.
+type ShowAllCommand struct {
+	ShowChartArgs
+}
+
+// Help returns command help message.
+func (ShowAllCommand) Help() string {
+	return heredoc.Docf(`
+		Inspects a chart (directory, file, or URL) and displays all its content
+		(values.yaml, Chart.yaml, README).
+
+		Usage:
+		  helm show all [CHART] [flags]
+
+		Flags:
+		%s
+	`, indent.String(renderSupportedFlags(SupportedShowFlags{}), 4))
+}
+
+// ShowChartCommand holds possible show chart options such as positional arguments and supported flags.
+type ShowChartCommand struct {
+	ShowChartArgs
+}
+
+// Help returns command help message.
+func (ShowChartCommand) Help() string {
+	return heredoc.Docf(`
+		Inspects a chart (directory, file, or URL) and displays the contents
+		of the Chart.yaml file.
+
+		Usage:
+		  helm show chart [CHART] [flags]
+
+		Flags:
+		%s
+	`, indent.String(renderSupportedFlags(SupportedShowFlags{}), 4))
+}
+
+// ShowReadmeCommand holds possible show readme options such as positional arguments and supported flags.
+type ShowReadmeCommand struct {
+	ShowChartArgs
+}
+
+// Help returns command help message.
+func (ShowReadmeCommand) Help() string {
+	return heredoc.Docf(`
+		Inspects a chart (directory, file, or URL) and displays the contents
+		of the README file.
+
+		Usage:
+		  helm show readme [CHART] [flags]
+
+		Flags:
+		%s
+	`, indent.String(renderSupportedFlags(SupportedShowFlags{}), 4))
+}
+
+// ShowValuesCommand holds possible show values options such as positional arguments and supported flags.
+type ShowValuesCommand struct {
+	ShowChartArgs
+
+	SupportedShowValuesFlags
+}
+
+// Help returns command help message.
+func (ShowValuesCommand) Help() string {
+	return heredoc.Docf(`
+		Inspects a chart (directory, file, or URL) and displays the contents
+		of the values.yaml file.
+
+		Usage:
+		  helm show values [CHART] [flags]
+
+		Flags:
+		%s
+		%s
+	`,
+		indent.String(renderSupportedFlags(SupportedShowFlags{}), 4),       // root flags
+		indent.String(renderSupportedFlags(SupportedShowValuesFlags{}), 4), // specific values flags
+	)
+}
+
+// SupportedShowValuesFlags represent flags that are supported both by Helm CLI and Helm Plugin.
+type SupportedShowValuesFlags struct {
+	JSONPath string `arg:"--jsonpath"`
+}
@@ -0,0 +1,91 @@
+package helm
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/muesli/reflow/indent"
+)
+
+// TemplateCommand holds possible template options such as positional arguments and supported flags.
+// Syntax:
+//
+//	helm template [NAME] [CHART] [flags]
+type TemplateCommand struct {
+	Name  string `arg:"positional"`
+	Chart string `arg:"positional"`
+
+	SupportedTemplateFlags
+	NotSupportedTemplateFlags
+}
+
+// Validate validates that all template parameters are valid.
+func (t TemplateCommand) Validate() error {
+	if strings.HasPrefix(t.Chart, "oci://") || strings.HasPrefix(t.Name, "oci://") {
+		return errors.New("Rendering Helm chart from OCI registry is not supported.")
+	}
+	return returnErrorOfAllSetFlags(t.NotSupportedTemplateFlags)
+}
+
+// Help returns command help message.
+func (TemplateCommand) Help() string {
+	return heredoc.Docf(`
+		Render chart templates locally and display the output.
+
+		Any values that would normally be looked up or retrieved in-cluster will be
+		faked locally. Additionally, none of the server-side testing of chart validity
+		(e.g. whether an API is supported) is done.
+
+		Usage:
+		  helm template [NAME] [CHART] [flags]
+
+		Flags:
+		%s
+	`, indent.String(renderSupportedFlags(SupportedTemplateFlags{}), 4))
+}
+
+// SupportedTemplateFlags represent flags that are supported both by Helm CLI and Helm Plugin.
+type SupportedTemplateFlags struct {
+	APIVersions              []string `arg:"-a,--api-versions"`
+	CreateNamespace          bool     `arg:"--create-namespace"`
+	DependencyUpdate         bool     `arg:"--dependency-update"`
+	Description              string   `arg:"--description"`
+	Devel                    bool     `arg:"--devel"`
+	DisableOpenAPIValidation bool     `arg:"--disable-openapi-validation"`
+	GenerateName             bool     `arg:"-g,--generate-name"`
+	IncludeCRDs              bool     `arg:"--include-crds"`
+	InsecureSkipTLSVerify    bool     `arg:"--insecure-skip-tls-verify"`
+	IsUpgrade                bool     `arg:"--is-upgrade"`
+	KubeVersion              string   `arg:"--kube-version"`
+	NameTemplate             string   `arg:"--name-template"`
+	NoHooks                  bool     `arg:"--no-hooks"`
+	PassCredentials          bool     `arg:"--pass-credentials"`
+	Password                 string   `arg:"--password"`
+	RenderSubChartNotes      bool     `arg:"--render-subchart-notes"`
+	Repo                     string   `arg:"--repo"`
+	Set                      []string `arg:"--set"`
+	SetString                []string `arg:"--set-string"`
+	ShowOnly                 []string `arg:"-s,--show-only"`
+	SkipCRDs                 bool     `arg:"--skip-crds"`
+	SkipTests                bool     `arg:"--skip-tests"`
+	Username                 string   `arg:"--username"`
+	ValidateManifests        bool     `arg:"--validate"`
+	Verify                   bool     `arg:"--verify"`
+	Version                  string   `arg:"--version"`
+}
+
+// NotSupportedTemplateFlags represents flags supported by Helm CLI but not by Helm Plugin.
+type NotSupportedTemplateFlags struct {
+	Atomic       bool     `arg:"--atomic"`
+	CaFile       string   `arg:"--ca-file"`
+	CertFile     string   `arg:"--cert-file"`
+	KeyFile      string   `arg:"--key-file"`
+	Keyring      string   `arg:"--keyring"`
+	OutputDir    string   `arg:"--output-dir"`
+	PostRenderer string   `arg:"--post-renderer"`
+	ReleaseName  bool     `arg:"--release-name"`
+	SetFile      []string `arg:"--set-file"`
+	Values       []string `arg:"-f,--values"`
+	Wait         bool     `arg:"--wait"`
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 )
 
 var hasher = sha256.New()
@@ -16,30 +18,87 @@ func sha(in string) string {
 	return base64.URLEncoding.EncodeToString(hasher.Sum(nil))
 }
 
-// EnsureDownloaded downloads given sources only if not yet downloaded.
-// It's a weak comparison based on the source path.
+// EnsureDownloaded downloads given sources only if not yet downloaded, or if their refresh interval elapsed.
+// It's a weak comparison based on the source path and version.
 func EnsureDownloaded(ctx context.Context, templateSources []Source, dir string) error {
 	for _, tpl := range templateSources {
-		dst := filepath.Join(dir, sha(tpl.Ref))
-		err := runIfFileDoesNotExist(dst, func() error {
-			return Download(ctx, tpl.Ref, dst)
-		})
+		dst := tpl.dir(dir)
+		stale, err := isStale(dst, tpl.RefreshInterval)
 		if err != nil {
 			return err
 		}
+		if !stale {
+			continue
+		}
+		if err := downloadAndSwap(ctx, tpl, dst); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func runIfFileDoesNotExist(path string, fn func() error) error {
-	_, err := os.Stat(path)
+// ForceDownload downloads given sources even if they were already downloaded.
+func ForceDownload(ctx context.Context, templateSources []Source, dir string) error {
+	for _, tpl := range templateSources {
+		if err := downloadAndSwap(ctx, tpl, tpl.dir(dir)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isStale returns true if a given path doesn't exist, or it's older than the refresh interval.
+func isStale(path string, refreshInterval time.Duration) (bool, error) {
+	// Lstat is used as local sources are linked instead of copied.
+	info, err := os.Lstat(path)
 	switch {
 	case err == nil:
 	case os.IsNotExist(err):
-		return fn()
+		return true, nil
 	default:
-		return err
+		return false, err
 	}
-	return nil
+
+	if refreshInterval <= 0 {
+		return false, nil
+	}
+	return time.Since(info.ModTime()) >= refreshInterval, nil
+}
+
+// downloadAndSwap downloads a given source into a temporary directory, verifies it and replaces the previous download.
+// If download or verification fails, the previous download is kept untouched.
+func downloadAndSwap(ctx context.Context, src Source, dst string) error {
+	tmpDst := fmt.Sprintf("%s.%d.tmp", dst, time.Now().UnixNano())
+	defer os.RemoveAll(tmpDst)
+
+	if err := Download(ctx, src.URL(), tmpDst); err != nil {
+		return fmt.Errorf("while downloading %q: %w", src.URL(), err)
+	}
+
+	if src.Checksum != "" {
+		got, err := Checksum(tmpDst)
+		if err != nil {
+			return fmt.Errorf("while calculating checksum of %q: %w", src.URL(), err)
+		}
+		if got != src.Checksum {
+			return fmt.Errorf("checksum mismatch for %q: expected %q, got %q", src.URL(), src.Checksum, got)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return fmt.Errorf("while creating directory for %q: %w", src.URL(), err)
+	}
+
+	old := fmt.Sprintf("%s.old", dst)
+	if err := os.RemoveAll(old); err != nil {
+		return fmt.Errorf("while removing previous download of %q: %w", src.URL(), err)
+	}
+	if err := os.Rename(dst, old); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("while moving previous download of %q: %w", src.URL(), err)
+	}
+	if err := os.Rename(tmpDst, dst); err != nil {
+		return fmt.Errorf("while replacing download of %q: %w", src.URL(), err)
+	}
+	return os.RemoveAll(old)
 }
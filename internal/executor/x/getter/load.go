@@ -2,17 +2,50 @@ package getter
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/fs"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+const checksumPrefix = "sha256:"
+
 // Source holds information about source location.
 type Source struct {
 	Ref string `yaml:"ref"`
+	// Version pins the source to a given version, e.g. Git tag, branch, or commit SHA.
+	// It's passed to the go-getter as the 'ref' query parameter.
+	Version string `yaml:"version,omitempty"`
+	// RefreshInterval defines how often the source is downloaded again. If not set, it's downloaded only once.
+	RefreshInterval time.Duration `yaml:"refreshInterval,omitempty"`
+	// Checksum is the expected checksum of all YAML files from the source in the 'sha256:<hex>' format.
+	Checksum string `yaml:"checksum,omitempty"`
+}
+
+// URL returns the go-getter URL with the version pinned, if specified.
+func (s Source) URL() string {
+	if s.Version == "" {
+		return s.Ref
+	}
+
+	sep := "?"
+	if strings.Contains(s.Ref, "?") {
+		sep = "&"
+	}
+	return fmt.Sprintf("%s%sref=%s", s.Ref, sep, url.QueryEscape(s.Version))
+}
+
+// dir returns the directory where the source is downloaded. Each version is stored separately.
+func (s Source) dir(root string) string {
+	return filepath.Join(root, sha(s.URL()))
 }
 
 // Load downloads defined sources and read them from the FS.
@@ -26,37 +59,102 @@ func Load[T any](ctx context.Context, tmpDir string, templateSources []Source) (
 		return nil, err
 	}
 
+	return read[T](tmpDir, templateSources)
+}
+
+// Reload downloads defined sources even if they were already downloaded and read them from the FS.
+func Reload[T any](ctx context.Context, tmpDir string, templateSources []Source) ([]T, error) {
+	if len(templateSources) == 0 {
+		return nil, nil
+	}
+
+	err := ForceDownload(ctx, templateSources, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return read[T](tmpDir, templateSources)
+}
+
+// Checksum returns the checksum of all YAML files from a given directory in the 'sha256:<hex>' format.
+func Checksum(dir string) (string, error) {
+	files, err := yamlFiles(dir)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, path := range files {
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return "", err
+		}
+		file, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return "", fmt.Errorf("while reading file %q: %v", path, err)
+		}
+		// file name is included, so moving content between files changes the checksum
+		h.Write([]byte(filepath.ToSlash(rel)))
+		h.Write([]byte{0})
+		h.Write(file)
+		h.Write([]byte{0})
+	}
+	return checksumPrefix + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// read reads templates only from directories of given sources, so previous versions are not taken into account.
+func read[T any](tmpDir string, templateSources []Source) ([]T, error) {
 	var out []T
-	err = Walk(tmpDir, func(path string, d fs.DirEntry, err error) error {
+	for _, src := range templateSources {
+		files, err := yamlFiles(src.dir(tmpDir))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range files {
+			file, err := os.ReadFile(filepath.Clean(path))
+			if err != nil {
+				return nil, fmt.Errorf("while reading file %q: %v", path, err)
+			}
+
+			var cfg struct {
+				Templates []T `yaml:"templates"`
+			}
+			err = yaml.Unmarshal(file, &cfg)
+			if err != nil {
+				return nil, fmt.Errorf("while unmarshaling file %q: %v", path, err)
+			}
+			out = append(out, cfg.Templates...)
+		}
+	}
+
+	return out, nil
+}
+
+// yamlFiles returns sorted paths of all YAML files from a given directory. Hidden directories, such as '.git', are skipped.
+func yamlFiles(dir string) ([]string, error) {
+	var out []string
+	err := Walk(dir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
 		if d.IsDir() {
+			if path != dir && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
 			return nil
 		}
 
 		if filepath.Ext(d.Name()) != ".yaml" {
 			return nil
 		}
-
-		file, err := os.ReadFile(filepath.Clean(path))
-		if err != nil {
-			return fmt.Errorf("while reading file %q: %v", path, err)
-		}
-
-		var cfg struct {
-			Templates []T `yaml:"templates"`
-		}
-		err = yaml.Unmarshal(file, &cfg)
-		if err != nil {
-			return fmt.Errorf("while unmarshaling file %q: %v", path, err)
-		}
-		out = append(out, cfg.Templates...)
+		out = append(out, path)
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	sort.Strings(out)
 	return out, nil
 }
@@ -0,0 +1,36 @@
+package getter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		source Source
+		expURL string
+	}{
+		{
+			name:   "without version",
+			source: Source{Ref: "github.com/kubeshop/botkube//cmd/executor/exec/templates?ref=main"},
+			expURL: "github.com/kubeshop/botkube//cmd/executor/exec/templates?ref=main",
+		},
+		{
+			name:   "with version",
+			source: Source{Ref: "github.com/kubeshop/botkube//cmd/executor/exec/templates", Version: "v1.2.0"},
+			expURL: "github.com/kubeshop/botkube//cmd/executor/exec/templates?ref=v1.2.0",
+		},
+		{
+			name:   "with version and other query params",
+			source: Source{Ref: "git::https://example.com/templates.git?depth=1", Version: "feature/new-parsers"},
+			expURL: "git::https://example.com/templates.git?depth=1&ref=feature%2Fnew-parsers",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expURL, tc.source.URL())
+		})
+	}
+}
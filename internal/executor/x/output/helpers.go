@@ -1,6 +1,17 @@
 package output
 
-import "github.com/kubeshop/botkube/pkg/api"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/executor/x"
+	"github.com/kubeshop/botkube/internal/executor/x/state"
+	"github.com/kubeshop/botkube/internal/executor/x/template"
+	"github.com/kubeshop/botkube/pkg/api"
+)
 
 func noItemsMsg() api.Message {
 	return api.Message{
@@ -13,3 +24,154 @@ func noItemsMsg() api.Message {
 		},
 	}
 }
+
+// actionsSection returns the actions dropdown together with the raw output button.
+func actionsSection(actions []api.OptionItem, cmd string) api.Section {
+	if len(actions) == 0 {
+		return api.Section{}
+	}
+
+	btnBuilder := api.NewMessageButtonBuilder()
+	return api.Section{
+		Buttons: []api.Button{
+			btnBuilder.ForCommandWithoutDesc("Raw output", fmt.Sprintf("%s %s %s", x.BuiltinCmdPrefix, cmd, x.RawOutputIndicator)),
+		},
+		Selects: api.Selects{
+			Items: []api.Select{
+				{
+					Type:    api.StaticSelect,
+					Name:    "Actions",
+					Command: fmt.Sprintf("%s %s", api.MessageBotNamePlaceholder, x.BuiltinCmdPrefix),
+					OptionGroups: []api.OptionGroup{
+						{
+							Name:    "Actions",
+							Options: actions,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resolveSelectIdx returns the item index selected in a given dropdown.
+func resolveSelectIdx(state *state.Container, selectID string) int {
+	item := state.GetField(selectID)
+	if item == "" {
+		return 0
+	}
+
+	_, item, _ = strings.Cut(item, x.SelectIndexIndicator)
+	val, _ := strconv.Atoi(item)
+	return val
+}
+
+// maxSelectOptions is the maximum number of options rendered in a single dropdown. It's the Slack limit.
+const maxSelectOptions = 100
+
+// selectPage returns the current page index and the bounds of items rendered in dropdowns.
+func selectPage(paginate template.Paginate, total int) (page, start, stop int) {
+	size := paginate.Page
+	if size <= 0 || size > maxSelectOptions {
+		size = maxSelectOptions
+	}
+
+	lastPage := 0
+	if total > 0 {
+		lastPage = (total - 1) / size
+	}
+
+	page = paginate.CurrentPage
+	if page > lastPage {
+		page = lastPage
+	}
+	if page < 0 {
+		page = 0
+	}
+
+	start = page * size
+	stop = start + size
+	if stop > total {
+		stop = total
+	}
+	return page, start, stop
+}
+
+// paginationSection returns buttons to switch between dropdown pages.
+func paginationSection(cmd string, page, stop, total int) api.Section {
+	btnsBuilder := api.NewMessageButtonBuilder()
+
+	var btns []api.Button
+	if page > 0 {
+		btns = append(btns, btnsBuilder.ForCommandWithoutDesc("Prev", fmt.Sprintf("%s %s %s%d", x.BuiltinCmdPrefix, cmd, x.PageIndexIndicator, page-1)))
+	}
+	if stop < total {
+		btns = append(btns, btnsBuilder.ForCommandWithoutDesc("Next", fmt.Sprintf("%s %s %s%d", x.BuiltinCmdPrefix, cmd, x.PageIndexIndicator, page+1), api.ButtonStylePrimary))
+	}
+	return api.Section{
+		Buttons: btns,
+	}
+}
+
+// dropdownID returns the select ID for a given command. It's also used as a command executed after selecting an item.
+func dropdownID(cmd string, page int) string {
+	id := strings.TrimSpace(fmt.Sprintf("%s %s", x.BuiltinCmdPrefix, cmd))
+	if page > 0 {
+		id = fmt.Sprintf("%s %s%d", id, x.PageIndexIndicator, page)
+	}
+	return id
+}
+
+// pagedSelect returns a dropdown with options for a given page of items. The keys hold the option names for items
+// starting from the offset index. Items with empty keys are skipped. It returns the index of the selected item.
+func pagedSelect(log logrus.FieldLogger, name, selectID string, keys []string, offset int, state *state.Container) (*api.Select, int) {
+	log = log.WithField("selectName", name)
+
+	var (
+		options  []api.OptionItem
+		itemIdxs []int
+	)
+	for idx, key := range keys {
+		if key == "" {
+			log.Info("key name is empty for dropdown")
+			continue
+		}
+		itemIdx := offset + idx
+		options = append(options, api.OptionItem{
+			Name:  key,
+			Value: fmt.Sprintf("%s%d", x.SelectIndexIndicator, itemIdx),
+		})
+		itemIdxs = append(itemIdxs, itemIdx)
+	}
+
+	if len(options) == 0 {
+		return nil, 0
+	}
+
+	// the option value holds the item index, find the matching option as items with empty keys are skipped.
+	selected := 0
+	requestedIdx := resolveSelectIdx(state, selectID)
+	for idx, itemIdx := range itemIdxs {
+		if itemIdx == requestedIdx {
+			selected = idx
+			break
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"itemsNo":      len(options),
+		"selectedItem": itemIdxs[selected],
+	}).Info("Dropdown rendered")
+	return &api.Select{
+		Type:          api.StaticSelect,
+		Name:          name,
+		Command:       fmt.Sprintf("%s %s", api.MessageBotNamePlaceholder, selectID), // storing select ID under command, so we can easily locate it from a given state
+		InitialOption: &options[selected],
+		OptionGroups: []api.OptionGroup{
+			{
+				Name:    name,
+				Options: options,
+			},
+		},
+	}, itemIdxs[selected]
+}
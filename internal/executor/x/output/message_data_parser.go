@@ -0,0 +1,203 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/internal/executor/x/parser"
+	"github.com/kubeshop/botkube/internal/executor/x/state"
+	"github.com/kubeshop/botkube/internal/executor/x/template"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// DataParser defines a parser for structured command output.
+type DataParser interface {
+	Items(in, path string) (parser.DataOutput, error)
+}
+
+// DataCommandParser allows to render JSON or YAML command output into interactive message based on registered templates.
+// Selected items are exposed to Go templates as they were decoded, so nested fields can be accessed, e.g. '{{ .metadata.name }}'.
+type DataCommandParser struct {
+	parsers map[string]DataParser
+	log     logrus.FieldLogger
+}
+
+// NewDataCommandParser returns a new DataCommandParser instance.
+func NewDataCommandParser(log logrus.FieldLogger) *DataCommandParser {
+	return &DataCommandParser{
+		log: log,
+		parsers: map[string]DataParser{
+			"json": &parser.DataJSON{},
+			"yaml": &parser.DataYAML{},
+		},
+	}
+}
+
+// RenderMessage renders the output string based on a given template.
+func (p *DataCommandParser) RenderMessage(cmd, output string, state *state.Container, msgCtx *template.Template) (api.Message, error) {
+	msg := msgCtx.ParseMessage
+	parserType := strings.TrimPrefix(msgCtx.Type, "parser:")
+	dataParser, found := p.parsers[parserType]
+	if !found {
+		note := fmt.Sprintf("parser %s is not supported", parserType)
+		return api.NewPlaintextMessage(note, false), nil
+	}
+
+	out, err := dataParser.Items(output, msg.JSONPath)
+	if err != nil {
+		return api.Message{}, err
+	}
+	if len(out.Items) == 0 {
+		return noItemsMsg(), nil
+	}
+
+	var sections []api.Section
+
+	// dropdowns
+	page, start, stop := selectPage(msg.Paginate, len(out.Items))
+	dropdowns, selectedIdx, err := p.renderDropdowns(msg.Selects, out.Items, cmd, state, page, start, stop)
+	if err != nil {
+		return api.Message{}, err
+	}
+	sections = append(sections, dropdowns)
+
+	item := out.Items[selectedIdx]
+
+	// preview
+	preview, err := p.renderPreview(msg, parserType, item)
+	if err != nil {
+		return api.Message{}, err
+	}
+	sections = append(sections, preview)
+
+	// actions
+	actions, err := p.renderActions(msg, cmd, item)
+	if err != nil {
+		return api.Message{}, err
+	}
+	sections = append(sections, actions)
+	sections = append(sections, paginationSection(cmd, page, stop, len(out.Items)))
+
+	return api.Message{
+		ReplaceOriginal:   (state != nil && state.SelectsBlockID != "") || page > 0, // dropdown clicked or page changed, let's do the update
+		OnlyVisibleForYou: true,
+		Sections:          sections,
+	}, nil
+}
+
+func (p *DataCommandParser) renderActions(msgCtx template.ParseMessage, cmd string, item any) (api.Section, error) {
+	names := make([]string, 0, len(msgCtx.Actions))
+	for name := range msgCtx.Actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var actions []api.OptionItem
+	for _, name := range names { // based on the selected item
+		out, err := p.renderGoTemplate(msgCtx.Actions[name], item)
+		if err != nil {
+			return api.Section{}, err
+		}
+		actions = append(actions, api.OptionItem{
+			Name:  name,
+			Value: out,
+		})
+	}
+	return actionsSection(actions, cmd), nil
+}
+
+func (p *DataCommandParser) renderPreview(msgCtx template.ParseMessage, format string, item any) (api.Section, error) {
+	var (
+		preview string
+		err     error
+	)
+	switch {
+	case msgCtx.Preview != "":
+		preview, err = p.renderGoTemplate(msgCtx.Preview, item)
+	case format == "yaml":
+		var raw []byte
+		raw, err = yaml.Marshal(item)
+		preview = string(raw)
+	default:
+		var raw []byte
+		raw, err = json.MarshalIndent(item, "", "  ")
+		preview = string(raw)
+	}
+	if err != nil {
+		return api.Section{}, fmt.Errorf("while rendering preview: %w", err)
+	}
+
+	return api.Section{
+		Base: api.Base{
+			Body: api.Body{
+				CodeBlock: strings.TrimSpace(preview),
+			},
+		},
+	}, nil
+}
+
+func (p *DataCommandParser) renderDropdowns(selects []template.Select, items []any, cmd string, state *state.Container, page, start, stop int) (api.Section, int, error) {
+	var (
+		dropdowns       []api.Select
+		lastSelectedIdx = start
+	)
+	for _, item := range selects {
+		dropdown, selectedIdx, err := p.selectDropdown(item.Name, cmd, item.KeyTpl, items, state, page, start, stop)
+		if err != nil {
+			return api.Section{}, 0, err
+		}
+
+		if dropdown != nil {
+			dropdowns = append(dropdowns, *dropdown)
+			lastSelectedIdx = selectedIdx
+		}
+	}
+
+	return api.Section{
+		Selects: api.Selects{
+			ID:    state.GetSelectsBlockID(),
+			Items: dropdowns,
+		},
+	}, lastSelectedIdx, nil
+}
+
+func (p *DataCommandParser) selectDropdown(name, cmd, keyTpl string, items []any, state *state.Container, page, start, stop int) (*api.Select, int, error) {
+	var keys []string
+	for _, item := range items[start:stop] {
+		selectItemName, err := p.renderGoTemplate(keyTpl, item)
+		if err != nil {
+			return nil, 0, fmt.Errorf("while rendering %q select key: %w", name, err)
+		}
+		keys = append(keys, selectItemName)
+	}
+
+	dropdown, selectedIdx := pagedSelect(p.log, name, dropdownID(cmd, page), keys, start, state)
+	return dropdown, selectedIdx, nil
+}
+
+func (p *DataCommandParser) renderGoTemplate(tpl string, data any) (string, error) {
+	p.log.WithFields(logrus.Fields{
+		"tpl":  tpl,
+		"data": data,
+	}).Debug("Rendering Go template")
+
+	// missing keys are rendered as empty values, as not all items have to define all nested fields.
+	tmpl, err := gotemplate.New("tpl").Option("missingkey=zero").Parse(tpl)
+	if err != nil {
+		return "", err
+	}
+
+	var buff strings.Builder
+	err = tmpl.Execute(&buff, data)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ReplaceAll(buff.String(), "<no value>", ""), nil
+}
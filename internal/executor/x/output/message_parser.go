@@ -2,14 +2,12 @@ package output
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 	gotemplate "text/template"
 
 	"github.com/huandu/xstrings"
 	"github.com/sirupsen/logrus"
 
-	"github.com/kubeshop/botkube/internal/executor/x"
 	"github.com/kubeshop/botkube/internal/executor/x/parser"
 	"github.com/kubeshop/botkube/internal/executor/x/state"
 	"github.com/kubeshop/botkube/internal/executor/x/template"
@@ -32,7 +30,10 @@ func NewTableCommandParser(log logrus.FieldLogger) *TableCommandParser {
 	return &TableCommandParser{
 		log: log,
 		parsers: map[string]Parser{
-			"space": &parser.TableSpace{},
+			"space":          &parser.TableSpace{},
+			"csv":            &parser.TableCSV{},
+			"tsv":            &parser.TableTSV{},
+			"header-aligned": &parser.TableHeaderAligned{},
 		},
 	}
 }
@@ -41,8 +42,11 @@ func NewTableCommandParser(log logrus.FieldLogger) *TableCommandParser {
 func (p *TableCommandParser) RenderMessage(cmd, output string, state *state.Container, msgCtx *template.Template) (api.Message, error) {
 	msg := msgCtx.ParseMessage
 	parserType := strings.TrimPrefix(msgCtx.Type, "parser:table:")
-	parser, found := p.parsers[parserType]
-	if !found {
+	parser, err := p.getParser(parserType, msg)
+	if err != nil {
+		return api.Message{}, err
+	}
+	if parser == nil {
 		note := fmt.Sprintf("parser %s is not supported", parserType)
 		return api.NewPlaintextMessage(note, false), nil
 	}
@@ -55,7 +59,8 @@ func (p *TableCommandParser) RenderMessage(cmd, output string, state *state.Cont
 	var sections []api.Section
 
 	// dropdowns
-	dropdowns, selectedIdx := p.renderDropdowns(msg.Selects, out.Table, cmd, state)
+	page, start, stop := selectPage(msg.Paginate, len(out.Table.Rows))
+	dropdowns, selectedIdx := p.renderDropdowns(msg.Selects, out.Table, cmd, state, page, start, stop)
 	sections = append(sections, dropdowns)
 	// preview
 	preview, err := p.renderPreview(msg, out, selectedIdx)
@@ -70,19 +75,32 @@ func (p *TableCommandParser) RenderMessage(cmd, output string, state *state.Cont
 		return api.Message{}, err
 	}
 	sections = append(sections, actions)
+	sections = append(sections, paginationSection(cmd, page, stop, len(out.Table.Rows)))
 
 	return api.Message{
-		ReplaceOriginal:   state != nil && state.SelectsBlockID != "", // dropdown clicked, let's do the update
+		ReplaceOriginal:   (state != nil && state.SelectsBlockID != "") || page > 0, // dropdown clicked or page changed, let's do the update
 		OnlyVisibleForYou: true,
 		Sections:          sections,
 	}, nil
 }
 
+// getParser returns parser for a given type. It returns nil if the type is not supported.
+func (p *TableCommandParser) getParser(parserType string, msg template.ParseMessage) (Parser, error) {
+	if parserType == "regex" {
+		// the regex parser depends on the template pattern, so it cannot be registered upfront.
+		regexParser, err := parser.NewTableRegex(msg.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("while creating regex parser: %w", err)
+		}
+		return regexParser, nil
+	}
+	return p.parsers[parserType], nil
+}
+
 func (p *TableCommandParser) renderActions(msgCtx template.ParseMessage, table parser.Table, cmd string, idx int) (api.Section, error) {
 	if idx >= len(table.Rows) {
 		idx = len(table.Rows) - 1
 	}
-	btnBuilder := api.NewMessageButtonBuilder()
 	var actions []api.OptionItem
 	for name, tpl := range msgCtx.Actions { // based on the selected item
 		out, err := p.renderGoTemplate(tpl, table.Headers, table.Rows[idx])
@@ -94,30 +112,7 @@ func (p *TableCommandParser) renderActions(msgCtx template.ParseMessage, table p
 			Value: out,
 		})
 	}
-	if len(actions) == 0 {
-		return api.Section{}, nil
-	}
-
-	return api.Section{
-		Buttons: []api.Button{
-			btnBuilder.ForCommandWithoutDesc("Raw output", fmt.Sprintf("%s %s %s", x.BuiltinCmdPrefix, cmd, x.RawOutputIndicator)),
-		},
-		Selects: api.Selects{
-			Items: []api.Select{
-				{
-					Type:    api.StaticSelect,
-					Name:    "Actions",
-					Command: fmt.Sprintf("%s %s", api.MessageBotNamePlaceholder, x.BuiltinCmdPrefix),
-					OptionGroups: []api.OptionGroup{
-						{
-							Name:    "Actions",
-							Options: actions,
-						},
-					},
-				},
-			},
-		},
-	}, nil
+	return actionsSection(actions, cmd), nil
 }
 
 func (p *TableCommandParser) renderPreview(msgCtx template.ParseMessage, out parser.TableOutput, requestedRow int) (api.Section, error) {
@@ -161,17 +156,17 @@ func (*TableCommandParser) getPreviewLine(lines []string, idx int) string {
 	return lines[1] // otherwise default first line
 }
 
-func (p *TableCommandParser) renderDropdowns(selects []template.Select, commandData parser.Table, cmd string, state *state.Container) (api.Section, int) {
+func (p *TableCommandParser) renderDropdowns(selects []template.Select, commandData parser.Table, cmd string, state *state.Container, page, start, stop int) (api.Section, int) {
 	var (
 		dropdowns       []api.Select
-		lastSelectedIdx int
+		lastSelectedIdx = start
 	)
 	for _, item := range selects {
 		var (
 			name   = item.Name
 			keyTpl = item.KeyTpl
 		)
-		dropdown, selectedIdx := p.selectDropdown(name, cmd, keyTpl, commandData, state)
+		dropdown, selectedIdx := p.selectDropdown(name, cmd, keyTpl, commandData, state, page, start, stop)
 
 		if dropdown != nil {
 			dropdowns = append(dropdowns, *dropdown)
@@ -187,62 +182,17 @@ func (p *TableCommandParser) renderDropdowns(selects []template.Select, commandD
 	}, lastSelectedIdx
 }
 
-func (p *TableCommandParser) selectDropdown(name, cmd, keyTpl string, table parser.Table, state *state.Container) (*api.Select, int) {
-	log := p.log.WithField("selectName", name)
-	var options []api.OptionItem
-	for idx, row := range table.Rows {
+func (p *TableCommandParser) selectDropdown(name, cmd, keyTpl string, table parser.Table, state *state.Container, page, start, stop int) (*api.Select, int) {
+	var keys []string
+	for _, row := range table.Rows[start:stop] {
 		selectItemName, err := p.renderGoTemplate(keyTpl, table.Headers, row)
 		if err != nil {
 			return nil, 0
 		}
-		if selectItemName == "" {
-			log.Info("key name is empty for dropdown")
-			continue
-		}
-		options = append(options, api.OptionItem{
-			Name:  selectItemName,
-			Value: fmt.Sprintf("%s%d", x.SelectIndexIndicator, idx),
-		})
-	}
-
-	if len(options) == 0 {
-		return nil, 0
-	}
-
-	dropdownID := fmt.Sprintf("%s %s", x.BuiltinCmdPrefix, cmd)
-	dropdownID = strings.TrimSpace(dropdownID)
-	idx := p.resolveSelectIdx(state, dropdownID)
-	if idx >= len(options) {
-		idx = len(options) - 1
-	}
-
-	log.WithFields(logrus.Fields{
-		"itemsNo":      len(options),
-		"selectedItem": idx,
-	}).Info("Dropdown rendered")
-	return &api.Select{
-		Type:          api.StaticSelect,
-		Name:          name,
-		Command:       fmt.Sprintf("%s %s", api.MessageBotNamePlaceholder, dropdownID), // storing select ID under command, so we can easily locate it from a given state
-		InitialOption: &options[idx],
-		OptionGroups: []api.OptionGroup{
-			{
-				Name:    name,
-				Options: options,
-			},
-		},
-	}, idx
-}
-
-func (*TableCommandParser) resolveSelectIdx(state *state.Container, selectID string) int {
-	item := state.GetField(selectID)
-	if item == "" {
-		return 0
+		keys = append(keys, selectItemName)
 	}
 
-	_, item, _ = strings.Cut(item, x.SelectIndexIndicator)
-	val, _ := strconv.Atoi(item)
-	return val
+	return pagedSelect(p.log, name, dropdownID(cmd, page), keys, start, state)
 }
 
 func (p *TableCommandParser) renderGoTemplate(tpl string, cols, rows []string) (string, error) {
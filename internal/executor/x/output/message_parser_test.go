@@ -0,0 +1,97 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/executor/x/state"
+	"github.com/kubeshop/botkube/internal/executor/x/template"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestTableCommandParserPaginatesSelect(t *testing.T) {
+	// given
+	lines := []string{"name,status"}
+	for i := 0; i < 5; i++ {
+		lines = append(lines, fmt.Sprintf("app-%d,running", i))
+	}
+	output := strings.Join(lines, "\n")
+
+	tpl := template.Template{
+		Type: "parser:table:csv",
+		ParseMessage: template.ParseMessage{
+			Selects: []template.Select{
+				{Name: "Apps", KeyTpl: "{{ .Name }}"},
+			},
+			Actions: map[string]string{
+				"logs": "app logs {{ .Name }}",
+			},
+			Paginate: template.Paginate{Page: 2, CurrentPage: 1},
+		},
+	}
+	st := &state.Container{
+		SelectsBlockID: "dropdowns",
+		Fields: map[string]string{
+			"exec run app list @page:1": "@idx:3",
+		},
+	}
+
+	parser := NewTableCommandParser(loggerx.NewNoop())
+
+	// when
+	msg, err := parser.RenderMessage("app list", output, st, &tpl)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, msg.ReplaceOriginal)
+	require.Len(t, msg.Sections, 4)
+
+	dropdown := msg.Sections[0].Selects.Items[0]
+	assert.Equal(t, "{{BotName}} exec run app list @page:1", dropdown.Command)
+	assert.Equal(t, []api.OptionItem{
+		{Name: "app-2", Value: "@idx:2"},
+		{Name: "app-3", Value: "@idx:3"},
+	}, dropdown.OptionGroups[0].Options)
+	assert.Equal(t, "@idx:3", dropdown.InitialOption.Value)
+
+	assert.Equal(t, "name   status\napp-3  running", msg.Sections[1].Body.CodeBlock)
+	assert.Equal(t, "app logs app-3", msg.Sections[2].Selects.Items[0].OptionGroups[0].Options[0].Value)
+
+	pagination := msg.Sections[3].Buttons
+	require.Len(t, pagination, 2)
+	assert.Equal(t, "{{BotName}} exec run app list @page:0", pagination[0].Command)
+	assert.Equal(t, "{{BotName}} exec run app list @page:2", pagination[1].Command)
+}
+
+func TestTableCommandParserRegex(t *testing.T) {
+	// given
+	output := "web: running\nworker: crashed\nnot matching line"
+	tpl := template.Template{
+		Type: "parser:table:regex",
+		ParseMessage: template.ParseMessage{
+			Pattern: `^(?P<NAME>\w+): (?P<STATUS>\w+)$`,
+			Selects: []template.Select{
+				{Name: "Apps", KeyTpl: "{{ .Name }} ({{ .Status }})"},
+			},
+		},
+	}
+
+	parser := NewTableCommandParser(loggerx.NewNoop())
+
+	// when
+	msg, err := parser.RenderMessage("app list", output, nil, &tpl)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, msg.ReplaceOriginal)
+	assert.Equal(t, []api.OptionItem{
+		{Name: "web (running)", Value: "@idx:0"},
+		{Name: "worker (crashed)", Value: "@idx:1"},
+	}, msg.Sections[0].Selects.Items[0].OptionGroups[0].Options)
+	assert.Empty(t, msg.Sections[3].Buttons)
+}
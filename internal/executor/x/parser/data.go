@@ -0,0 +1,88 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// DataOutput holds items extracted from structured command output.
+type DataOutput struct {
+	Items []any
+}
+
+// DataJSON destructs command output printed in the JSON format.
+type DataJSON struct{}
+
+// Items decodes a given JSON input and returns items selected by the JSONPath expression.
+func (*DataJSON) Items(in, path string) (DataOutput, error) {
+	var data any
+	if err := json.Unmarshal([]byte(in), &data); err != nil {
+		return DataOutput{}, fmt.Errorf("while unmarshaling JSON output: %w", err)
+	}
+	return selectItems(data, path)
+}
+
+// DataYAML destructs command output printed in the YAML format.
+type DataYAML struct{}
+
+// Items decodes a given YAML input and returns items selected by the JSONPath expression.
+func (*DataYAML) Items(in, path string) (DataOutput, error) {
+	// converting to JSON first ensures that nested objects are decoded as map[string]any, the same as for JSON output.
+	raw, err := yaml.YAMLToJSON([]byte(in))
+	if err != nil {
+		return DataOutput{}, fmt.Errorf("while converting YAML output: %w", err)
+	}
+	var data any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return DataOutput{}, fmt.Errorf("while unmarshaling YAML output: %w", err)
+	}
+	return selectItems(data, path)
+}
+
+// selectItems returns items matching the JSONPath expression, e.g. '{.items[*]}'.
+// If the path is not specified, the top-level list items are returned, or the whole object if it's not a list.
+func selectItems(data any, path string) (DataOutput, error) {
+	if strings.TrimSpace(path) == "" {
+		if list, ok := data.([]any); ok {
+			return DataOutput{Items: list}, nil
+		}
+		if data == nil {
+			return DataOutput{}, nil
+		}
+		return DataOutput{Items: []any{data}}, nil
+	}
+
+	jp := jsonpath.New("items").AllowMissingKeys(true)
+	if err := jp.Parse(normalizeJSONPath(path)); err != nil {
+		return DataOutput{}, fmt.Errorf("while parsing %q JSONPath expression: %w", path, err)
+	}
+
+	results, err := jp.FindResults(data)
+	if err != nil {
+		return DataOutput{}, fmt.Errorf("while finding items for %q JSONPath expression: %w", path, err)
+	}
+
+	var out DataOutput
+	for _, result := range results {
+		for _, val := range result {
+			if !val.IsValid() || !val.CanInterface() {
+				continue
+			}
+			out.Items = append(out.Items, val.Interface())
+		}
+	}
+	return out, nil
+}
+
+// normalizeJSONPath wraps the JSONPath expression in curly braces if they were omitted, e.g. '.items[*]'.
+func normalizeJSONPath(path string) string {
+	path = strings.TrimSpace(path)
+	if strings.HasPrefix(path, "{") {
+		return path
+	}
+	return fmt.Sprintf("{%s}", path)
+}
@@ -0,0 +1,104 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataJSONItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		path     string
+		expItems []any
+	}{
+		{
+			name:  "top-level list without path",
+			input: `[{"name": "psql", "namespace": "default"}, {"name": "traefik", "namespace": "kube-system"}]`,
+			expItems: []any{
+				map[string]any{"name": "psql", "namespace": "default"},
+				map[string]any{"name": "traefik", "namespace": "kube-system"},
+			},
+		},
+		{
+			name:  "nested items with path",
+			input: `{"kind": "List", "items": [{"metadata": {"name": "nginx", "labels": {"app": "web"}}}]}`,
+			path:  "{.items[*]}",
+			expItems: []any{
+				map[string]any{"metadata": map[string]any{"name": "nginx", "labels": map[string]any{"app": "web"}}},
+			},
+		},
+		{
+			name:     "path without curly braces",
+			input:    `{"items": [{"name": "a"}, {"name": "b"}]}`,
+			path:     ".items[*].name",
+			expItems: []any{"a", "b"},
+		},
+		{
+			name:     "single object without path",
+			input:    `{"name": "psql"}`,
+			expItems: []any{map[string]any{"name": "psql"}},
+		},
+		{
+			name:  "missing key",
+			input: `{"kind": "List"}`,
+			path:  "{.items[*]}",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			parser := &DataJSON{}
+
+			// when
+			out, err := parser.Items(tc.input, tc.path)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expItems, out.Items)
+		})
+	}
+}
+
+func TestDataYAMLItems(t *testing.T) {
+	// given
+	input := heredoc.Doc(`
+		apiVersion: v1
+		kind: List
+		items:
+		  - metadata:
+		      name: nginx
+		      namespace: default
+		    spec:
+		      replicas: 2
+		  - metadata:
+		      name: redis
+		      namespace: db
+		    spec:
+		      replicas: 1`)
+
+	parser := &DataYAML{}
+
+	// when
+	out, err := parser.Items(input, "{.items[*].metadata}")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"name": "nginx", "namespace": "default"},
+		map[string]any{"name": "redis", "namespace": "db"},
+	}, out.Items)
+}
+
+func TestDataItemsErrors(t *testing.T) {
+	// when
+	_, jsonErr := (&DataJSON{}).Items("not a json", "")
+	_, pathErr := (&DataJSON{}).Items(`{"items": []}`, "{.items[}")
+
+	// then
+	assert.ErrorContains(t, jsonErr, "while unmarshaling JSON output")
+	assert.ErrorContains(t, pathErr, `while parsing "{.items[}" JSONPath expression`)
+}
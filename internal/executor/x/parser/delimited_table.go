@@ -0,0 +1,81 @@
+package parser
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// TableCSV destructs table printed in the comma-separated values format.
+type TableCSV struct{}
+
+// TableSeparated takes a CSV input and returns the table where the first record is used as headers.
+func (*TableCSV) TableSeparated(in string) TableOutput {
+	return delimitedTable(in, ',')
+}
+
+// TableTSV destructs table printed in the tab-separated values format.
+type TableTSV struct{}
+
+// TableSeparated takes a TSV input and returns the table where the first record is used as headers.
+func (*TableTSV) TableSeparated(in string) TableOutput {
+	return delimitedTable(in, '\t')
+}
+
+func delimitedTable(in string, delimiter rune) TableOutput {
+	reader := csv.NewReader(strings.NewReader(strings.TrimSpace(in)))
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1 // rows may have fewer cells than headers
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var table Table
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// return what was parsed so far, the raw output is still available for the user
+			break
+		}
+		if table.Headers == nil {
+			table.Headers = record
+			continue
+		}
+		table.Rows = append(table.Rows, normalizeRow(record, len(table.Headers)))
+	}
+
+	if table.Headers == nil {
+		return TableOutput{}
+	}
+	return TableOutput{
+		Table: table,
+		Lines: alignedLines(table),
+	}
+}
+
+// normalizeRow ensures that a given row has exactly the same number of cells as headers.
+func normalizeRow(row []string, size int) []string {
+	out := make([]string, size)
+	copy(out, row)
+	return out
+}
+
+// alignedLines renders a given table into lines with cells aligned into columns. It's used for parsers which
+// input lines are not aligned, so the command preview is still readable.
+func alignedLines(table Table) []string {
+	var buff strings.Builder
+	w := tabwriter.NewWriter(&buff, 0, 0, 2, ' ', 0)
+	for _, row := range append([][]string{table.Headers}, table.Rows...) {
+		_, _ = w.Write([]byte(strings.Join(row, "\t") + "\n"))
+	}
+	_ = w.Flush()
+
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSuffix(buff.String(), "\n"), "\n") {
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+	return lines
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"bufio"
+	"strings"
+)
+
+// TableHeaderAligned destructs table which column boundaries are derived from the header offsets.
+// Unlike TableSpace, cells may contain single spaces, e.g. timestamps or commands.
+type TableHeaderAligned struct{}
+
+// TableSeparated takes a string input and returns the table where cells are cut at the positions where
+// the header columns start. Header columns have to be separated by at least two spaces or a tab.
+func (*TableHeaderAligned) TableSeparated(in string) TableOutput {
+	var out TableOutput
+	in = replaceTabsWithSpaces(in)
+	in = strings.Trim(in, "\n")
+	scanner := bufio.NewScanner(strings.NewReader(in))
+
+	var offsets []int
+	if scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " ")
+		offsets = getColumnOffsets(line)
+		out.Lines = append(out.Lines, line)
+		out.Table.Headers = cutIntoCells(line, offsets)
+	}
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " ")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		out.Lines = append(out.Lines, line)
+		out.Table.Rows = append(out.Table.Rows, cutIntoCells(line, offsets))
+	}
+	return out
+}
+
+// getColumnOffsets returns positions where the header columns start.
+func getColumnOffsets(header string) []int {
+	var offsets []int
+	for idx, ch := range header {
+		if ch == ' ' {
+			continue
+		}
+		if len(offsets) == 0 || (idx >= 2 && header[idx-1] == ' ' && header[idx-2] == ' ') {
+			offsets = append(offsets, idx)
+		}
+	}
+	return offsets
+}
+
+// cutIntoCells cuts a given line at column offsets. The last cell takes the rest of the line.
+func cutIntoCells(line string, offsets []int) []string {
+	cells := make([]string, 0, len(offsets))
+	for idx, start := range offsets {
+		end := len(line)
+		if idx+1 < len(offsets) && offsets[idx+1] < end {
+			end = offsets[idx+1]
+		}
+		if start >= end {
+			cells = append(cells, "")
+			continue
+		}
+		cells = append(cells, strings.TrimSpace(line[start:end]))
+	}
+	return cells
+}
@@ -0,0 +1,66 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// TableRegex destructs table lines using a regular expression with named capture groups.
+// Group names are used as headers, and lines that don't match the expression are skipped.
+type TableRegex struct {
+	pattern *regexp.Regexp
+	headers []string
+}
+
+// NewTableRegex returns a new TableRegex instance.
+func NewTableRegex(pattern string) (*TableRegex, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("while compiling %q pattern: %w", pattern, err)
+	}
+
+	var headers []string
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			headers = append(headers, name)
+		}
+	}
+	if len(headers) == 0 {
+		return nil, fmt.Errorf("pattern %q doesn't have any named capture groups", pattern)
+	}
+
+	return &TableRegex{
+		pattern: re,
+		headers: headers,
+	}, nil
+}
+
+// TableSeparated takes a string input and returns the table built from lines matching the pattern.
+func (t *TableRegex) TableSeparated(in string) TableOutput {
+	table := Table{
+		Headers: t.headers,
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(in))
+	for scanner.Scan() {
+		match := t.pattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+
+		var row []string
+		for idx, name := range t.pattern.SubexpNames() {
+			if name != "" {
+				row = append(row, strings.TrimSpace(match[idx]))
+			}
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	return TableOutput{
+		Table: table,
+		Lines: alignedLines(table),
+	}
+}
@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTableParsersShared checks that all table parsers return the same table for the same data printed in different formats.
+func TestTableParsersShared(t *testing.T) {
+	// given
+	expectedTable := Table{
+		Headers: []string{"NAME", "NAMESPACE", "UPDATED", "STATUS"},
+		Rows: [][]string{
+			{"psql", "default", "2023-04-27 19:30:48 +0200 CEST", "deployed"},
+			{"traefik", "kube-system", "2023-04-19 20:58:57 +0000 UTC", "failed"},
+		},
+	}
+
+	regexParser, err := NewTableRegex(`^(?P<NAME>\S+)\s+(?P<NAMESPACE>\S+)\s+(?P<UPDATED>\d{4}-\d{2}-\d{2} [\d:]+ \S+ \S+)\s+(?P<STATUS>\S+)$`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		parser interface {
+			TableSeparated(in string) TableOutput
+		}
+		input string
+	}{
+		{
+			name:   "CSV",
+			parser: &TableCSV{},
+			input: heredoc.Doc(`
+				NAME,NAMESPACE,UPDATED,STATUS
+				psql,default,2023-04-27 19:30:48 +0200 CEST,deployed
+				traefik,kube-system,"2023-04-19 20:58:57 +0000 UTC",failed`),
+		},
+		{
+			name:   "TSV",
+			parser: &TableTSV{},
+			input: "NAME\tNAMESPACE\tUPDATED\tSTATUS\n" +
+				"psql\tdefault\t2023-04-27 19:30:48 +0200 CEST\tdeployed\n" +
+				"traefik\tkube-system\t2023-04-19 20:58:57 +0000 UTC\tfailed\n",
+		},
+		{
+			name:   "Header aligned",
+			parser: &TableHeaderAligned{},
+			input: heredoc.Doc(`
+				NAME     NAMESPACE    UPDATED                          STATUS
+				psql     default      2023-04-27 19:30:48 +0200 CEST   deployed
+				traefik  kube-system  2023-04-19 20:58:57 +0000 UTC    failed`),
+		},
+		{
+			name:   "Regex",
+			parser: regexParser,
+			input: heredoc.Doc(`
+				NAME     NAMESPACE    UPDATED                          STATUS
+				psql     default      2023-04-27 19:30:48 +0200 CEST   deployed
+				traefik  kube-system  2023-04-19 20:58:57 +0000 UTC    failed`),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out := tc.parser.TableSeparated(tc.input)
+
+			// then
+			assert.Equal(t, expectedTable, out.Table)
+			require.Len(t, out.Lines, 3)
+		})
+	}
+}
+
+func TestTableHeaderAlignedDockerPs(t *testing.T) {
+	// given
+	input := heredoc.Doc(`
+		CONTAINER ID   IMAGE         COMMAND                  CREATED        STATUS        PORTS      NAMES
+		3e5bd1b8f5a1   redis:7       "docker-entrypoint.s…"   2 hours ago    Up 2 hours    6379/tcp   cache
+		a1b2c3d4e5f6   nginx:1.25    "/docker-entrypoint.…"   3 days ago     Exited (0)               web`)
+
+	parser := &TableHeaderAligned{}
+
+	// when
+	out := parser.TableSeparated(input)
+
+	// then
+	assert.Equal(t, []string{"CONTAINER ID", "IMAGE", "COMMAND", "CREATED", "STATUS", "PORTS", "NAMES"}, out.Table.Headers)
+	assert.Equal(t, [][]string{
+		{"3e5bd1b8f5a1", "redis:7", `"docker-entrypoint.s…"`, "2 hours ago", "Up 2 hours", "6379/tcp", "cache"},
+		{"a1b2c3d4e5f6", "nginx:1.25", `"/docker-entrypoint.…"`, "3 days ago", "Exited (0)", "", "web"},
+	}, out.Table.Rows)
+}
+
+func TestTableCSVAlignedLines(t *testing.T) {
+	// given
+	input := heredoc.Doc(`
+		name,status
+		"api, v2",running
+		worker`)
+
+	parser := &TableCSV{}
+
+	// when
+	out := parser.TableSeparated(input)
+
+	// then
+	assert.Equal(t, [][]string{{"api, v2", "running"}, {"worker", ""}}, out.Table.Rows)
+	assert.Equal(t, []string{
+		"name     status",
+		"api, v2  running",
+		"worker",
+	}, out.Lines)
+}
+
+func TestNewTableRegexErrors(t *testing.T) {
+	// when
+	_, invalidErr := NewTableRegex(`(?P<NAME>`)
+	_, noGroupsErr := NewTableRegex(`^\S+$`)
+
+	// then
+	assert.ErrorContains(t, invalidErr, `while compiling "(?P<NAME>" pattern`)
+	assert.EqualError(t, noGroupsErr, `pattern "^\\S+$" doesn't have any named capture groups`)
+}
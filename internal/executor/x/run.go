@@ -69,6 +69,7 @@ func (i *Runner) RunWithTemplates(templates []template.Template, state *state.Co
 		}
 
 		cmdTemplate.TutorialMessage.Paginate.CurrentPage = cmd.PageIndex
+		cmdTemplate.ParseMessage.Paginate.CurrentPage = cmd.PageIndex
 		message, err := render.RenderMessage(cmd.ToExecute, cmdOutput, state, &cmdTemplate)
 		if err != nil {
 			return executor.ExecuteOutput{}, err
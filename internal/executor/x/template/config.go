@@ -36,6 +36,11 @@ type (
 		Selects []Select          `yaml:"selects"`
 		Actions map[string]string `yaml:"actions"`
 		Preview string            `yaml:"preview"`
+		// JSONPath selects items from the JSON or YAML output, e.g. '{.items[*]}'. Used only by data parsers.
+		JSONPath string `yaml:"jsonPath"`
+		// Pattern is a regular expression with named capture groups. Used only by the 'parser:table:regex' parser.
+		Pattern  string   `yaml:"pattern"`
+		Paginate Paginate `yaml:"paginate"`
 	}
 
 	// WrapMessage holds template for wrapping command output with additional context.
@@ -0,0 +1,116 @@
+package x
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/executor/x/getter"
+	"github.com/kubeshop/botkube/internal/executor/x/template"
+)
+
+// TemplateStore holds templates loaded from configured sources. The loaded template set is swapped atomically,
+// so commands that are already processed keep using the previous set.
+type TemplateStore struct {
+	mu      sync.Mutex // serializes loading
+	current atomic.Pointer[templateSet]
+	now     func() time.Time
+}
+
+type templateSet struct {
+	key       string
+	templates []template.Template
+	loadedAt  time.Time
+}
+
+// NewTemplateStore returns a new TemplateStore instance.
+func NewTemplateStore() *TemplateStore {
+	return &TemplateStore{
+		now: time.Now,
+	}
+}
+
+// Get returns templates for a given configuration. Templates are loaded on the first call, when the configured
+// sources change, or when the shortest refresh interval of configured sources elapsed.
+func (s *TemplateStore) Get(ctx context.Context, log logrus.FieldLogger, cfg Config) ([]template.Template, error) {
+	if set := s.current.Load(); s.isUpToDate(set, cfg) {
+		return set.templates, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// templates might have been already loaded while waiting for the lock
+	prev := s.current.Load()
+	if s.isUpToDate(prev, cfg) {
+		return prev.templates, nil
+	}
+
+	templates, err := getter.Load[template.Template](ctx, cfg.TmpDir.GetDirectory(), cfg.Templates)
+	if err != nil {
+		if prev == nil || prev.key != sourcesKey(cfg) {
+			return nil, err
+		}
+		// the refresh failed, but we still have templates for the same sources, so we don't break command execution
+		log.WithError(err).Warn("Failed to refresh templates. Using previously loaded ones.")
+		s.store(cfg, prev.templates)
+		return prev.templates, nil
+	}
+
+	s.store(cfg, templates)
+	return templates, nil
+}
+
+// Reload downloads all configured sources again and swaps the loaded template set.
+func (s *TemplateStore) Reload(ctx context.Context, cfg Config) ([]template.Template, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	templates, err := getter.Reload[template.Template](ctx, cfg.TmpDir.GetDirectory(), cfg.Templates)
+	if err != nil {
+		return nil, fmt.Errorf("while reloading templates: %w", err)
+	}
+
+	s.store(cfg, templates)
+	return templates, nil
+}
+
+func (s *TemplateStore) store(cfg Config, templates []template.Template) {
+	s.current.Store(&templateSet{
+		key:       sourcesKey(cfg),
+		templates: templates,
+		loadedAt:  s.now(),
+	})
+}
+
+func (s *TemplateStore) isUpToDate(set *templateSet, cfg Config) bool {
+	if set == nil || set.key != sourcesKey(cfg) {
+		return false
+	}
+
+	interval := refreshInterval(cfg.Templates)
+	return interval <= 0 || s.now().Sub(set.loadedAt) < interval
+}
+
+// refreshInterval returns the shortest refresh interval of given sources.
+func refreshInterval(sources []getter.Source) time.Duration {
+	var out time.Duration
+	for _, src := range sources {
+		if src.RefreshInterval <= 0 {
+			continue
+		}
+		if out == 0 || src.RefreshInterval < out {
+			out = src.RefreshInterval
+		}
+	}
+	return out
+}
+
+// sourcesKey identifies a given sources configuration.
+func sourcesKey(cfg Config) string {
+	return fmt.Sprintf("%s:%v", cfg.TmpDir.GetDirectory(), cfg.Templates)
+}
@@ -0,0 +1,145 @@
+package x
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/executor/x/getter"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/plugin"
+)
+
+var (
+	oneTemplate = heredoc.Doc(`
+		templates:
+		  - trigger:
+		      command:
+		        prefix: "helm list"
+		    type: "parser:table:space"`)
+	twoTemplates = heredoc.Doc(`
+		templates:
+		  - trigger:
+		      command:
+		        prefix: "helm list"
+		    type: "parser:table:space"
+		  - trigger:
+		      command:
+		        prefix: "helm history"
+		    type: "parser:table:space"`)
+)
+
+func TestTemplateStoreReload(t *testing.T) {
+	// given
+	srcDir := t.TempDir()
+	writeTemplates(t, srcDir, oneTemplate)
+
+	cfg := Config{
+		Templates: []getter.Source{{Ref: srcDir}},
+		TmpDir:    plugin.TmpDir(t.TempDir()),
+	}
+	store := NewTemplateStore()
+
+	// when
+	initial, err := store.Get(context.Background(), loggerx.NewNoop(), cfg)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, initial, 1)
+
+	// when
+	writeTemplates(t, srcDir, twoTemplates)
+	cached, err := store.Get(context.Background(), loggerx.NewNoop(), cfg)
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, cached, 1, "templates should be loaded only once when refresh interval is not set")
+
+	// when
+	reloaded, err := store.Reload(context.Background(), cfg)
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, reloaded, 2)
+	assert.Len(t, initial, 1, "previously returned templates should not be affected")
+
+	got, err := store.Get(context.Background(), loggerx.NewNoop(), cfg)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+}
+
+func TestTemplateStoreRefreshInterval(t *testing.T) {
+	// given
+	srcDir := t.TempDir()
+	writeTemplates(t, srcDir, oneTemplate)
+
+	cfg := Config{
+		Templates: []getter.Source{{Ref: srcDir, RefreshInterval: time.Minute}},
+		TmpDir:    plugin.TmpDir(t.TempDir()),
+	}
+
+	now := time.Now()
+	store := NewTemplateStore()
+	store.now = func() time.Time { return now }
+
+	_, err := store.Get(context.Background(), loggerx.NewNoop(), cfg)
+	require.NoError(t, err)
+	writeTemplates(t, srcDir, twoTemplates)
+
+	// when
+	beforeInterval, err := store.Get(context.Background(), loggerx.NewNoop(), cfg)
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, beforeInterval, 1)
+
+	// when
+	now = now.Add(time.Minute)
+	afterInterval, err := store.Get(context.Background(), loggerx.NewNoop(), cfg)
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, afterInterval, 2)
+}
+
+func TestTemplateStoreChecksumMismatch(t *testing.T) {
+	// given
+	srcDir := t.TempDir()
+	writeTemplates(t, srcDir, oneTemplate)
+
+	checksum, err := getter.Checksum(srcDir)
+	require.NoError(t, err)
+
+	cfg := Config{
+		Templates: []getter.Source{{Ref: srcDir, Checksum: checksum}},
+		TmpDir:    plugin.TmpDir(t.TempDir()),
+	}
+	store := NewTemplateStore()
+
+	_, err = store.Get(context.Background(), loggerx.NewNoop(), cfg)
+	require.NoError(t, err)
+
+	// when
+	writeTemplates(t, srcDir, twoTemplates)
+	_, err = store.Reload(context.Background(), cfg)
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+
+	got, err := store.Get(context.Background(), loggerx.NewNoop(), cfg)
+	require.NoError(t, err)
+	assert.Len(t, got, 1, "previously loaded templates should be kept")
+}
+
+func writeTemplates(t *testing.T, dir, content string) {
+	t.Helper()
+	err := os.WriteFile(filepath.Join(dir, "templates.yaml"), []byte(content), 0o600)
+	require.NoError(t, err)
+}
@@ -0,0 +1,246 @@
+package httpx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maxSharedBodyBytes limits the size of request bodies buffered to pass them to multiple handlers of the same path.
+// Handlers can apply lower limits on their own.
+const maxSharedBodyBytes = 4 << 20 // 4 MiB
+
+// ServerPool shares HTTP servers between handlers registered for the same port.
+// It allows multiple plugin streams running in a single process to receive requests on one port, routed by path.
+type ServerPool struct {
+	mu      sync.Mutex
+	servers map[int]*pooledServer
+}
+
+type pooledServer struct {
+	log    logrus.FieldLogger
+	srv    *http.Server
+	routes map[string]*route
+	mu     sync.RWMutex
+}
+
+// route holds handlers registered for a single path. All handlers must be registered for the same owner,
+// e.g. the same source configuration streamed for interactive and non-interactive platforms.
+type route struct {
+	owner    string
+	handlers []*registration
+}
+
+// registration wraps a registered handler, so it can be found on removal even if the handler type is not comparable.
+type registration struct {
+	http.Handler
+}
+
+// NewServerPool returns a new ServerPool instance.
+func NewServerPool() *ServerPool {
+	return &ServerPool{
+		servers: map[int]*pooledServer{},
+	}
+}
+
+// Handle registers a handler for a given port and path until the context is canceled. The server for a given port
+// is started on the first registration and stopped once all its handlers are unregistered.
+// Handlers for the same path must have the same owner, otherwise an error is returned. Requests for such path
+// are passed to all its handlers.
+func (p *ServerPool) Handle(ctx context.Context, log logrus.FieldLogger, port int, path, owner string, handler http.Handler) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	srv, found := p.servers[port]
+	if !found {
+		var err error
+		srv, err = startPooledServer(log, port)
+		if err != nil {
+			return err
+		}
+		p.servers[port] = srv
+	}
+
+	reg := &registration{Handler: handler}
+	if err := srv.addHandler(path, owner, reg); err != nil {
+		if len(srv.routes) == 0 {
+			p.stopServer(port, srv)
+		}
+		return fmt.Errorf("while registering handler on port %d: %w", port, err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		p.removeHandler(port, path, reg)
+	}()
+	return nil
+}
+
+func (p *ServerPool) removeHandler(port int, path string, reg *registration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	srv, found := p.servers[port]
+	if !found {
+		return
+	}
+	if srv.removeHandler(path, reg) > 0 {
+		return
+	}
+	p.stopServer(port, srv)
+}
+
+func (p *ServerPool) stopServer(port int, srv *pooledServer) {
+	delete(p.servers, port)
+	srv.log.Infof("No handlers left. Stopping server on port %d...", port)
+	if err := srv.srv.Shutdown(context.Background()); err != nil {
+		srv.log.Errorf("while shutting down server: %s", err.Error())
+	}
+}
+
+func startPooledServer(log logrus.FieldLogger, port int) (*pooledServer, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, fmt.Errorf("while listening on port %d: %w", port, err)
+	}
+
+	out := &pooledServer{
+		log:    log,
+		routes: map[string]*route{},
+	}
+	out.srv = &http.Server{Handler: out, ReadHeaderTimeout: readHeaderTimeout}
+
+	log.Infof("Starting server on address %q", listener.Addr().String())
+	go func() {
+		if err := out.srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("while serving requests on port %d: %s", port, err.Error())
+		}
+	}()
+	return out, nil
+}
+
+func (s *pooledServer) addHandler(path, owner string, reg *registration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, found := s.routes[path]
+	if !found {
+		s.routes[path] = &route{owner: owner, handlers: []*registration{reg}}
+		return nil
+	}
+	if r.owner != owner {
+		return fmt.Errorf("path %q is already used by a handler with a different configuration", path)
+	}
+	r.handlers = append(r.handlers, reg)
+	return nil
+}
+
+// removeHandler returns the number of handlers left on the server.
+func (s *pooledServer) removeHandler(path string, reg *registration) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, found := s.routes[path]; found {
+		for idx, h := range r.handlers {
+			if h == reg {
+				r.handlers = append(r.handlers[:idx], r.handlers[idx+1:]...)
+				break
+			}
+		}
+		if len(r.handlers) == 0 {
+			delete(s.routes, path)
+		}
+	}
+
+	left := 0
+	for _, r := range s.routes {
+		left += len(r.handlers)
+	}
+	return left
+}
+
+// ServeHTTP passes the request to all handlers registered for the request path.
+// If any handler fails, its response is returned. Otherwise, the response of the first handler is returned.
+func (s *pooledServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.RLock()
+	var handlers []http.Handler
+	if r, found := s.routes[req.URL.Path]; found {
+		for _, reg := range r.handlers {
+			handlers = append(handlers, reg)
+		}
+	}
+	s.mu.RUnlock()
+
+	switch len(handlers) {
+	case 0:
+		http.NotFound(w, req)
+		return
+	case 1:
+		handlers[0].ServeHTTP(w, req)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxSharedBodyBytes))
+	if err != nil {
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, fmt.Sprintf("while reading request body: %s", err.Error()), status)
+		return
+	}
+
+	var out *bufferedResponse
+	for _, h := range handlers {
+		resp := newBufferedResponse()
+		reqCopy := req.Clone(req.Context())
+		reqCopy.Body = io.NopCloser(bytes.NewReader(body))
+		h.ServeHTTP(resp, reqCopy)
+
+		if out == nil || (out.status < http.StatusBadRequest && resp.status >= http.StatusBadRequest) {
+			out = resp
+		}
+	}
+	out.writeTo(w)
+}
+
+// bufferedResponse keeps the response in memory, so a single response can be selected from multiple handlers.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponse() *bufferedResponse {
+	return &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+}
+
+func (r *bufferedResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *bufferedResponse) Write(in []byte) (int, error) {
+	return r.body.Write(in)
+}
+
+func (r *bufferedResponse) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *bufferedResponse) writeTo(w http.ResponseWriter) {
+	for key, values := range r.header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.body.Bytes())
+}
@@ -0,0 +1,123 @@
+package httpx_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/httpx"
+	"github.com/kubeshop/botkube/internal/loggerx"
+)
+
+func TestServerPoolSharesPort(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	port := freePort(t)
+	pool := httpx.NewServerPool()
+
+	var firstCalls, secondCalls atomic.Int32
+	first := recordingHandler(&firstCalls, http.StatusOK)
+	firstCopy := recordingHandler(&firstCalls, http.StatusOK)
+	second := recordingHandler(&secondCalls, http.StatusAccepted)
+
+	// when
+	require.NoError(t, pool.Handle(ctx, loggerx.NewNoop(), port, "/first", "first", first))
+	require.NoError(t, pool.Handle(ctx, loggerx.NewNoop(), port, "/first", "first", firstCopy))
+	require.NoError(t, pool.Handle(ctx, loggerx.NewNoop(), port, "/second", "second", second))
+	err := pool.Handle(ctx, loggerx.NewNoop(), port, "/second", "other", second)
+
+	// then
+	require.EqualError(t, err, fmt.Sprintf(`while registering handler on port %d: path "/second" is already used by a handler with a different configuration`, port))
+
+	assert.Equal(t, http.StatusOK, post(t, port, "/first"))
+	assert.Equal(t, int32(2), firstCalls.Load())
+
+	assert.Equal(t, http.StatusAccepted, post(t, port, "/second"))
+	assert.Equal(t, int32(1), secondCalls.Load())
+
+	assert.Equal(t, http.StatusNotFound, post(t, port, "/unknown"))
+
+	resp, err := http.Post(fmt.Sprintf("http://localhost:%d/first", port), "text/plain", strings.NewReader(strings.Repeat("x", 5<<20)))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	assert.Equal(t, int32(2), firstCalls.Load())
+}
+
+func TestServerPoolReleasesPort(t *testing.T) {
+	// given
+	ctx, cancel := context.WithCancel(context.Background())
+
+	port := freePort(t)
+	pool := httpx.NewServerPool()
+	var calls atomic.Int32
+	require.NoError(t, pool.Handle(ctx, loggerx.NewNoop(), port, "/path", "owner", recordingHandler(&calls, http.StatusOK)))
+
+	// when
+	cancel()
+
+	// then
+	assert.Eventually(t, func() bool {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			return false
+		}
+		_ = listener.Close()
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestServerPoolReturnsListenError(t *testing.T) {
+	// given
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	pool := httpx.NewServerPool()
+
+	// when
+	err = pool.Handle(context.Background(), loggerx.NewNoop(), port, "/path", "owner", http.NotFoundHandler())
+
+	// then
+	assert.ErrorContains(t, err, fmt.Sprintf("while listening on port %d", port))
+}
+
+func recordingHandler(calls *atomic.Int32, status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == "payload" {
+			calls.Add(1)
+		}
+		w.WriteHeader(status)
+	})
+}
+
+func post(t *testing.T, port int, path string) int {
+	t.Helper()
+
+	resp, err := http.Post(fmt.Sprintf("http://localhost:%d%s", port, path), "text/plain", strings.NewReader("payload"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp.StatusCode
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port
+}
@@ -1,4 +1,4 @@
-package github_events
+package jsonpathx
 
 import (
 	"encoding/json"
@@ -10,15 +10,19 @@ import (
 	"k8s.io/kubectl/pkg/cmd/get"
 )
 
-type JSONPathMatcher struct {
+// Matcher checks if JSON objects match JSONPath criteria.
+type Matcher struct {
 	log logrus.FieldLogger
 }
 
-func NewJSONPathMatcher(log logrus.FieldLogger) *JSONPathMatcher {
-	return &JSONPathMatcher{log: log}
+// NewMatcher returns a new Matcher instance.
+func NewMatcher(log logrus.FieldLogger) *Matcher {
+	return &Matcher{log: log}
 }
 
-func (j *JSONPathMatcher) IsEventMatchingCriteria(obj json.RawMessage, jsonPath, expValue string) bool {
+// IsEventMatchingCriteria returns true if a value found under a given JSONPath is equal to the expected one.
+// Empty JSONPath matches all objects.
+func (j *Matcher) IsEventMatchingCriteria(obj json.RawMessage, jsonPath, expValue string) bool {
 	if jsonPath == "" {
 		return true
 	}
@@ -34,7 +38,7 @@ func (j *JSONPathMatcher) IsEventMatchingCriteria(obj json.RawMessage, jsonPath,
 	return true
 }
 
-func (j *JSONPathMatcher) parseJsonpath(raw []byte, jsonpathStr string) (string, error) {
+func (j *Matcher) parseJsonpath(raw []byte, jsonpathStr string) (string, error) {
 	fields, err := get.RelaxedJSONPathExpression(jsonpathStr)
 	if err != nil {
 		return "", err
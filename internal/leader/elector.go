@@ -0,0 +1,98 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// ErrLeadershipLost is returned when the current replica stops being the leader.
+// The replica needs to be restarted, so it can join the election again as a standby.
+var ErrLeadershipLost = errors.New("leadership lost")
+
+// Elector runs the Lease-based leader election between Botkube replicas.
+type Elector struct {
+	log      logrus.FieldLogger
+	cfg      config.LeaderElection
+	k8sCli   kubernetes.Interface
+	identity string
+}
+
+// NewElector returns a new Elector instance.
+func NewElector(log logrus.FieldLogger, cfg config.LeaderElection, k8sCli kubernetes.Interface) (*Elector, error) {
+	identity := cfg.Identity
+	if identity == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("while getting hostname: %w", err)
+		}
+		identity = hostname
+	}
+
+	return &Elector{
+		log:      log.WithField("identity", identity),
+		cfg:      cfg,
+		k8sCli:   k8sCli,
+		identity: identity,
+	}, nil
+}
+
+// Run participates in the leader election until the context is cancelled or the leadership is lost.
+// The onStartedLeading function is called once the current replica becomes the leader.
+// On context cancellation the lease is released, so a standby replica can take over immediately.
+func (e *Elector) Run(ctx context.Context, onStartedLeading func()) error {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      e.cfg.Lease.Name,
+			Namespace: e.cfg.Lease.Namespace,
+		},
+		Client: e.k8sCli.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: e.identity,
+		},
+	}
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		Name:            e.cfg.Lease.Name,
+		LeaseDuration:   e.cfg.LeaseDuration,
+		RenewDeadline:   e.cfg.RenewDeadline,
+		RetryPeriod:     e.cfg.RetryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				e.log.Info("Started leading. Starting Botkube...")
+				onStartedLeading()
+			},
+			OnStoppedLeading: func() {
+				e.log.Info("Stopped leading.")
+			},
+			OnNewLeader: func(identity string) {
+				if identity == e.identity {
+					return
+				}
+				e.log.Infof("Running as standby. Current leader: %q", identity)
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("while creating leader elector: %w", err)
+	}
+
+	e.log.WithField("lease", e.cfg.Lease).Info("Starting leader election...")
+	elector.Run(ctx)
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	return ErrLeadershipLost
+}
@@ -0,0 +1,76 @@
+package leader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestElectorRun(t *testing.T) {
+	// given
+	k8sCli := fake.NewSimpleClientset()
+	cfg := config.LeaderElection{
+		Enabled: true,
+		Lease: config.K8sResourceRef{
+			Name:      "botkube-leader",
+			Namespace: "botkube",
+		},
+		Identity:      "botkube-0",
+		LeaseDuration: 2 * time.Second,
+		RenewDeadline: time.Second,
+		RetryPeriod:   100 * time.Millisecond,
+	}
+	elector, err := NewElector(loggerx.NewNoop(), cfg, k8sCli)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leading := make(chan struct{})
+	runErr := make(chan error, 1)
+
+	// when
+	go func() {
+		runErr <- elector.Run(ctx, func() { close(leading) })
+	}()
+
+	// then
+	select {
+	case <-leading:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for leadership")
+	}
+
+	lease, err := k8sCli.CoordinationV1().Leases("botkube").Get(context.Background(), "botkube-leader", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, lease.Spec.HolderIdentity)
+	assert.Equal(t, "botkube-0", *lease.Spec.HolderIdentity)
+
+	// when
+	cancel()
+
+	// then
+	select {
+	case err := <-runErr:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for elector to stop")
+	}
+}
+
+func TestNewElectorDefaultIdentity(t *testing.T) {
+	// when
+	elector, err := NewElector(loggerx.NewNoop(), config.LeaderElection{}, fake.NewSimpleClientset())
+
+	// then
+	require.NoError(t, err)
+	assert.NotEmpty(t, elector.identity)
+}
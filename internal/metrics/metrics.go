@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespace = "botkube"
+
+	// StatusSuccess is the status label value for successful operations.
+	StatusSuccess = "success"
+	// StatusError is the status label value for failed operations.
+	StatusError = "error"
+
+	// BuiltinExecutorLabel is the plugin label value for commands handled by built-in Botkube executors.
+	BuiltinExecutorLabel = "builtin"
+)
+
+// All collectors are registered in the default Prometheus registry, which is exposed by the agent metrics server.
+// Label sets are fixed and bounded by the configuration, e.g. enabled plugins and communication platforms.
+var (
+	sourceEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "source",
+		Name:      "events_total",
+		Help:      "Total number of events received from source plugins.",
+	}, []string{"plugin", "source"})
+
+	notificationsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "notifier",
+		Name:      "notifications_total",
+		Help:      "Total number of notifications sent to communication platforms and sinks.",
+	}, []string{"integration", "status"})
+
+	executorCommandsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "executor",
+		Name:      "commands_total",
+		Help:      "Total number of executed commands.",
+	}, []string{"plugin", "status"})
+
+	executorCommandDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "executor",
+		Name:      "command_duration_seconds",
+		Help:      "Duration of executed commands.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+	}, []string{"plugin"})
+
+	configReloadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "config",
+		Name:      "reloads_total",
+		Help:      "Total number of requested configuration reloads.",
+	}, []string{"status"})
+)
+
+// ReportSourceEvent records an event received from a given source plugin.
+func ReportSourceEvent(pluginName, sourceName string) {
+	sourceEventsTotal.WithLabelValues(pluginName, sourceName).Inc()
+}
+
+// ReportNotification records a notification delivery result for a given integration.
+func ReportNotification(integration string, err error) {
+	notificationsTotal.WithLabelValues(integration, status(err)).Inc()
+}
+
+// ObserveExecutorCommand records a command handled by a given executor plugin.
+func ObserveExecutorCommand(pluginName string, duration time.Duration, err error) {
+	executorCommandsTotal.WithLabelValues(pluginName, status(err)).Inc()
+	executorCommandDuration.WithLabelValues(pluginName).Observe(duration.Seconds())
+}
+
+// ReportConfigReload records a configuration reload request.
+func ReportConfigReload(err error) {
+	configReloadsTotal.WithLabelValues(status(err)).Inc()
+}
+
+func status(err error) string {
+	if err != nil {
+		return StatusError
+	}
+	return StatusSuccess
+}
@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportersUseStableLabels(t *testing.T) {
+	// when
+	ReportSourceEvent("botkube/kubernetes", "k8s-events")
+	ReportNotification("socketSlack", nil)
+	ReportNotification("socketSlack", errors.New("rate limited"))
+	ObserveExecutorCommand("botkube/kubectl", 150*time.Millisecond, nil)
+	ObserveExecutorCommand(BuiltinExecutorLabel, time.Millisecond, errors.New("invalid command"))
+	ReportConfigReload(nil)
+
+	// then
+	assert.Equal(t, 1.0, testutil.ToFloat64(sourceEventsTotal.WithLabelValues("botkube/kubernetes", "k8s-events")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(notificationsTotal.WithLabelValues("socketSlack", StatusSuccess)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(notificationsTotal.WithLabelValues("socketSlack", StatusError)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(executorCommandsTotal.WithLabelValues("botkube/kubectl", StatusSuccess)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(executorCommandsTotal.WithLabelValues(BuiltinExecutorLabel, StatusError)))
+	assert.Equal(t, 2, testutil.CollectAndCount(executorCommandDuration))
+	assert.Equal(t, 1.0, testutil.ToFloat64(configReloadsTotal.WithLabelValues(StatusSuccess)))
+}
@@ -61,6 +61,7 @@ func (c *Collector) GetAllEnabledAndUsedPlugins(cfg *config.Config) ([]string, [
 			for _, name := range commGroupCfg.Teams.Bindings.Sources {
 				bindSources[name] = struct{}{}
 			}
+			collect(commGroupCfg.Teams.Channels)
 		}
 
 		if commGroupCfg.Discord.Enabled {
@@ -1,6 +1,9 @@
 package plugin
 
 import (
+	"fmt"
+	"strings"
+
 	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
@@ -15,6 +18,14 @@ const (
 
 type KubeConfigInput struct {
 	Channel string
+	User    PlatformUserInput
+}
+
+// PlatformUserInput holds details about the chat user who executes a command.
+type PlatformUserInput struct {
+	ID     string
+	Email  string
+	Groups []string
 }
 
 func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx config.PluginContext, input KubeConfigInput) ([]byte, error) {
@@ -26,6 +37,12 @@ func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx conf
 	if rbac == nil {
 		return nil, nil
 	}
+
+	user, err := generateUserSubject(rbac.User, rbac.Group, input)
+	if err != nil {
+		return nil, err
+	}
+	groups := generateGroupSubject(rbac.Group, input)
 	apiCfg := clientcmdapi.Config{
 		Kind:       "Config",
 		APIVersion: "v1",
@@ -58,8 +75,8 @@ func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx conf
 					TokenFile:             restCfg.BearerTokenFile,
 					ClientCertificateData: restCfg.CertData,
 					ClientKeyData:         restCfg.KeyData,
-					Impersonate:           generateUserSubject(rbac.User, rbac.Group, input),
-					ImpersonateGroups:     generateGroupSubject(rbac.Group, input),
+					Impersonate:           user,
+					ImpersonateGroups:     groups,
 				},
 			},
 		},
@@ -73,12 +90,14 @@ func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx conf
 	return yamlKubeConfig, nil
 }
 
-func generateUserSubject(rbac config.UserPolicySubject, group config.GroupPolicySubject, input KubeConfigInput) (user string) {
+func generateUserSubject(rbac config.UserPolicySubject, group config.GroupPolicySubject, input KubeConfigInput) (user string, err error) {
 	switch rbac.Type {
 	case config.StaticPolicySubjectType:
 		user = rbac.Prefix + rbac.Static.Value
 	case config.ChannelNamePolicySubjectType:
 		user = rbac.Prefix + input.Channel
+	case config.PlatformUserPolicySubjectType:
+		return platformUserSubject(rbac, input.User)
 	default:
 		if group.Type != config.EmptyPolicySubjectType {
 			user = "botkube-internal-static-user"
@@ -87,6 +106,32 @@ func generateUserSubject(rbac config.UserPolicySubject, group config.GroupPolicy
 	return
 }
 
+func platformUserSubject(rbac config.UserPolicySubject, input PlatformUserInput) (string, error) {
+	attr := rbac.PlatformUser.Attribute
+	if attr == "" {
+		attr = config.PlatformUserIDAttribute
+	}
+
+	var value string
+	switch attr {
+	case config.PlatformUserIDAttribute:
+		value = input.ID
+	case config.PlatformUserEmailAttribute:
+		value = input.Email
+	default:
+		return "", fmt.Errorf("unknown platform user attribute %q", attr)
+	}
+	// never fall back to the Botkube identity
+	if value == "" {
+		return "", fmt.Errorf("cannot impersonate chat user as its %s is not available", strings.ToLower(string(attr)))
+	}
+
+	if mapped, found := rbac.PlatformUser.Mapping[value]; found {
+		return mapped, nil
+	}
+	return rbac.Prefix + value, nil
+}
+
 func generateGroupSubject(rbac config.GroupPolicySubject, input KubeConfigInput) (group []string) {
 	switch rbac.Type {
 	case config.StaticPolicySubjectType:
@@ -95,6 +140,14 @@ func generateGroupSubject(rbac config.GroupPolicySubject, input KubeConfigInput)
 		}
 	case config.ChannelNamePolicySubjectType:
 		group = append(group, rbac.Prefix+input.Channel)
+	case config.PlatformUserGroupPolicySubjectType:
+		for _, value := range input.User.Groups {
+			if mapped, found := rbac.PlatformUserGroup.Mapping[value]; found {
+				group = append(group, mapped)
+				continue
+			}
+			group = append(group, rbac.Prefix+value)
+		}
 	}
 	return
 }
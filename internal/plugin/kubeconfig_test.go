@@ -0,0 +1,114 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestGenerateKubeConfigForPlatformUser(t *testing.T) {
+	input := KubeConfigInput{
+		Channel: "ops",
+		User: PlatformUserInput{
+			ID:     "U0123",
+			Email:  "alice@example.com",
+			Groups: []string{"team-admins", "team-dev"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		rbac      config.PolicyRule
+		expUser   string
+		expGroups []string
+	}{
+		{
+			name: "Impersonate user ID by default",
+			rbac: config.PolicyRule{
+				User: config.UserPolicySubject{
+					Type:   config.PlatformUserPolicySubjectType,
+					Prefix: "slack:",
+				},
+			},
+			expUser: "slack:U0123",
+		},
+		{
+			name: "Impersonate user email",
+			rbac: config.PolicyRule{
+				User: config.UserPolicySubject{
+					Type: config.PlatformUserPolicySubjectType,
+					PlatformUser: config.PlatformUserSubject{
+						Attribute: config.PlatformUserEmailAttribute,
+					},
+				},
+			},
+			expUser: "alice@example.com",
+		},
+		{
+			name: "Map user and groups to Kubernetes subjects",
+			rbac: config.PolicyRule{
+				User: config.UserPolicySubject{
+					Type:   config.PlatformUserPolicySubjectType,
+					Prefix: "slack:",
+					PlatformUser: config.PlatformUserSubject{
+						Mapping: map[string]string{"U0123": "alice"},
+					},
+				},
+				Group: config.GroupPolicySubject{
+					Type:   config.PlatformUserGroupPolicySubjectType,
+					Prefix: "slack:",
+					PlatformUserGroup: config.PlatformUserGroupSubject{
+						Mapping: map[string]string{"team-admins": "cluster-admins"},
+					},
+				},
+			},
+			expUser:   "alice",
+			expGroups: []string{"cluster-admins", "slack:team-dev"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			pluginCtx := config.PluginContext{RBAC: &tc.rbac}
+
+			// when
+			raw, err := GenerateKubeConfig(&rest.Config{Host: "https://localhost:6443"}, "dev", pluginCtx, input)
+
+			// then
+			require.NoError(t, err)
+			kubeConfig, err := clientcmd.Load(raw)
+			require.NoError(t, err)
+			authInfo := kubeConfig.AuthInfos["dev"]
+			require.NotNil(t, authInfo)
+			assert.Equal(t, tc.expUser, authInfo.Impersonate)
+			assert.Equal(t, tc.expGroups, authInfo.ImpersonateGroups)
+		})
+	}
+}
+
+func TestGenerateKubeConfigForPlatformUserWithoutAttribute(t *testing.T) {
+	// given
+	pluginCtx := config.PluginContext{
+		RBAC: &config.PolicyRule{
+			User: config.UserPolicySubject{
+				Type: config.PlatformUserPolicySubjectType,
+				PlatformUser: config.PlatformUserSubject{
+					Attribute: config.PlatformUserEmailAttribute,
+				},
+			},
+		},
+	}
+	input := KubeConfigInput{User: PlatformUserInput{ID: "U0123"}}
+
+	// when
+	raw, err := GenerateKubeConfig(&rest.Config{}, "dev", pluginCtx, input)
+
+	// then
+	assert.EqualError(t, err, "cannot impersonate chat user as its email is not available")
+	assert.Nil(t, raw)
+}
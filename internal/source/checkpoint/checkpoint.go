@@ -0,0 +1,206 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const flushOnShutdownTimeout = 5 * time.Second
+
+// Config holds the checkpoint configuration of a given source.
+// If both FileName and ConfigMap are specified, the ConfigMap is used.
+type Config struct {
+	Enabled   bool                  `yaml:"enabled"`
+	FileName  string                `yaml:"fileName,omitempty"`
+	ConfigMap config.K8sResourceRef `yaml:"configMap,omitempty"`
+	// Key under which the checkpoint is stored in the ConfigMap. Sources which share the ConfigMap must use different keys.
+	Key string `yaml:"key,omitempty"`
+	// MaxAge limits how far back in time the source resumes after a restart.
+	MaxAge time.Duration `yaml:"maxAge,omitempty"`
+	// FlushInterval defines how often the checkpoint is persisted.
+	FlushInterval time.Duration `yaml:"flushInterval,omitempty"`
+}
+
+// Checkpoint describes the last events emitted by a given source.
+type Checkpoint struct {
+	// Timestamp of the newest emitted event.
+	Timestamp time.Time `json:"timestamp"`
+	// Keys of events emitted with exactly the same Timestamp.
+	// Kubernetes timestamps have a second precision, so the Timestamp alone is not enough to detect already emitted events.
+	Keys []string `json:"keys,omitempty"`
+	// State holds additional source-specific data, e.g. the last emitted state of a given alert.
+	State map[string]string `json:"state,omitempty"`
+}
+
+// Checkpointer tracks events emitted by a given source and persists them periodically.
+// After a restart, it allows the source to resume from the last checkpoint without emitting the same events again.
+type Checkpointer struct {
+	log           logrus.FieldLogger
+	store         Store
+	flushInterval time.Duration
+	since         time.Time
+
+	mu      sync.Mutex
+	current Checkpoint
+	dirty   bool
+}
+
+// New loads the last checkpoint from a given store and returns a new Checkpointer instance.
+// If there is no checkpoint yet, the source starts from the startTime.
+func New(ctx context.Context, log logrus.FieldLogger, cfg Config, store Store, startTime time.Time) (*Checkpointer, error) {
+	last, err := store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("while loading checkpoint: %w", err)
+	}
+
+	since := startTime
+	if !last.Timestamp.IsZero() {
+		since = last.Timestamp
+		oldest := startTime.Add(-cfg.MaxAge)
+		if cfg.MaxAge > 0 && since.Before(oldest) {
+			log.Infof("Checkpoint %s is older than %s. Resuming from %s.", since, cfg.MaxAge, oldest)
+			since = oldest
+		}
+	}
+	if last.State == nil {
+		last.State = map[string]string{}
+	}
+
+	log.Infof("Resuming from %s.", since)
+	return &Checkpointer{
+		log:           log,
+		store:         store,
+		flushInterval: cfg.FlushInterval,
+		since:         since,
+		current:       last,
+	}, nil
+}
+
+// Since returns the time from which the source should emit events.
+func (c *Checkpointer) Since() time.Time {
+	return c.since
+}
+
+// ShouldSkip returns true if a given event happened before the checkpoint or was already emitted.
+func (c *Checkpointer) ShouldSkip(timestamp time.Time, key string) bool {
+	if timestamp.Before(c.since) {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !timestamp.Equal(c.current.Timestamp) {
+		return false
+	}
+	return slices.Contains(c.current.Keys, key)
+}
+
+// Record marks events with given keys as emitted. Events older than the current checkpoint are ignored.
+func (c *Checkpointer) Record(timestamp time.Time, keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case timestamp.After(c.current.Timestamp):
+		c.current.Timestamp = timestamp
+		c.current.Keys = append([]string(nil), keys...)
+	case timestamp.Equal(c.current.Timestamp):
+		for _, key := range keys {
+			if !slices.Contains(c.current.Keys, key) {
+				c.current.Keys = append(c.current.Keys, key)
+			}
+		}
+	default:
+		return
+	}
+	c.dirty = true
+}
+
+// State returns a copy of the source-specific state.
+func (c *Checkpointer) State() map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return maps.Clone(c.current.State)
+}
+
+// SetState stores the source-specific state under a given key.
+func (c *Checkpointer) SetState(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.current.State[key] == value {
+		return
+	}
+	c.current.State[key] = value
+	c.dirty = true
+}
+
+// RetainState removes the source-specific state of all keys except the given ones.
+// It prevents the state from growing indefinitely, e.g. with alerts which are already resolved.
+func (c *Checkpointer) RetainState(keys []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.current.State {
+		if slices.Contains(keys, key) {
+			continue
+		}
+		delete(c.current.State, key)
+		c.dirty = true
+	}
+}
+
+// Run persists the checkpoint periodically until the context is cancelled.
+// The checkpoint is persisted for the last time on shutdown.
+func (c *Checkpointer) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), flushOnShutdownTimeout)
+			if err := c.Flush(flushCtx); err != nil {
+				c.log.Errorf("while persisting checkpoint on shutdown: %s", err)
+			}
+			cancel()
+			return
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil {
+				c.log.Errorf("while persisting checkpoint: %s", err)
+			}
+		}
+	}
+}
+
+// Flush persists the checkpoint if it has changed since the last call.
+func (c *Checkpointer) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.dirty {
+		c.mu.Unlock()
+		return nil
+	}
+	snapshot := c.current
+	snapshot.Keys = append([]string(nil), c.current.Keys...)
+	snapshot.State = maps.Clone(c.current.State)
+	c.dirty = false
+	c.mu.Unlock()
+
+	if err := c.store.Save(ctx, snapshot); err != nil {
+		c.mu.Lock()
+		c.dirty = true
+		c.mu.Unlock()
+		return err
+	}
+	return nil
+}
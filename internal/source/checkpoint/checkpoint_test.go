@@ -0,0 +1,137 @@
+package checkpoint
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestCheckpointerResumesFromLastCheckpoint(t *testing.T) {
+	// given
+	ctx := context.Background()
+	startTime := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	lastEvent := startTime.Add(-time.Minute)
+	store := &FileStore{fileName: filepath.Join(t.TempDir(), "checkpoint.json")}
+
+	cfg := Config{FlushInterval: time.Second}
+	before, err := New(ctx, loggerx.NewNoop(), cfg, store, startTime.Add(-time.Hour))
+	require.NoError(t, err)
+	before.Record(lastEvent, "pod/default/foo")
+	before.Record(lastEvent, "pod/default/bar")
+	before.SetState("alert", "firing")
+	require.NoError(t, before.Flush(ctx))
+
+	// when
+	after, err := New(ctx, loggerx.NewNoop(), cfg, store, startTime)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, lastEvent, after.Since())
+	assert.Equal(t, map[string]string{"alert": "firing"}, after.State())
+
+	assert.True(t, after.ShouldSkip(lastEvent.Add(-time.Second), "pod/default/baz"))
+	assert.True(t, after.ShouldSkip(lastEvent, "pod/default/foo"))
+	assert.True(t, after.ShouldSkip(lastEvent, "pod/default/bar"))
+	assert.False(t, after.ShouldSkip(lastEvent, "pod/default/baz"))
+	assert.False(t, after.ShouldSkip(lastEvent.Add(time.Second), "pod/default/foo"))
+}
+
+func TestCheckpointerRespectsMaxAge(t *testing.T) {
+	// given
+	ctx := context.Background()
+	startTime := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	store := &FileStore{fileName: filepath.Join(t.TempDir(), "checkpoint.json")}
+	require.NoError(t, store.Save(ctx, Checkpoint{Timestamp: startTime.Add(-24 * time.Hour)}))
+
+	// when
+	checkpointer, err := New(ctx, loggerx.NewNoop(), Config{MaxAge: time.Hour}, store, startTime)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, startTime.Add(-time.Hour), checkpointer.Since())
+}
+
+func TestCheckpointerWithoutCheckpoint(t *testing.T) {
+	// given
+	startTime := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	store := &FileStore{fileName: filepath.Join(t.TempDir(), "checkpoint.json")}
+
+	// when
+	checkpointer, err := New(context.Background(), loggerx.NewNoop(), Config{MaxAge: time.Hour}, store, startTime)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, startTime, checkpointer.Since())
+	assert.True(t, checkpointer.ShouldSkip(startTime.Add(-time.Second), "pod/default/foo"))
+	assert.False(t, checkpointer.ShouldSkip(startTime, "pod/default/foo"))
+}
+
+func TestCheckpointerRetainState(t *testing.T) {
+	// given
+	ctx := context.Background()
+	store := &FileStore{fileName: filepath.Join(t.TempDir(), "checkpoint.json")}
+	checkpointer, err := New(ctx, loggerx.NewNoop(), Config{}, store, time.Now())
+	require.NoError(t, err)
+
+	checkpointer.SetState("resolved", "firing")
+	checkpointer.SetState("firing", "pending")
+	checkpointer.SetState("pending", "pending")
+
+	// when
+	checkpointer.RetainState([]string{"firing", "pending", "unknown"})
+	require.NoError(t, checkpointer.Flush(ctx))
+
+	// then
+	saved, err := store.Load(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"firing": "pending", "pending": "pending"}, saved.State)
+}
+
+func TestConfigMapStore(t *testing.T) {
+	// given
+	ctx := context.Background()
+	k8sCli := fake.NewSimpleClientset()
+	cfg := Config{
+		ConfigMap: config.K8sResourceRef{Name: "botkube-checkpoints", Namespace: "botkube"},
+		Key:       "kubernetes",
+	}
+	store, err := NewStore(cfg, k8sCli)
+	require.NoError(t, err)
+
+	first := Checkpoint{Timestamp: time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC), Keys: []string{"foo"}}
+	second := Checkpoint{Timestamp: time.Date(2023, 7, 1, 12, 0, 1, 0, time.UTC), Keys: []string{"bar"}}
+
+	// when
+	empty, err := store.Load(ctx)
+	require.NoError(t, err)
+	require.NoError(t, store.Save(ctx, first))
+	require.NoError(t, store.Save(ctx, second))
+	got, err := store.Load(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, Checkpoint{}, empty)
+	assert.True(t, second.Timestamp.Equal(got.Timestamp))
+	assert.Equal(t, second.Keys, got.Keys)
+
+	cm, err := k8sCli.CoreV1().ConfigMaps("botkube").Get(ctx, "botkube-checkpoints", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, cm.Data, "kubernetes")
+}
+
+func TestNewStoreWithoutKubeConfig(t *testing.T) {
+	// when
+	_, err := NewStore(Config{ConfigMap: config.K8sResourceRef{Name: "botkube-checkpoints"}}, nil)
+
+	// then
+	assert.EqualError(t, err, "the kubeconfig is required to store checkpoints in a ConfigMap")
+}
@@ -0,0 +1,146 @@
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Store persists source checkpoints.
+type Store interface {
+	Load(ctx context.Context) (Checkpoint, error)
+	Save(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// NewStore returns the checkpoint store based on a given configuration.
+// The Kubernetes clientset is required only if the ConfigMap store is configured.
+func NewStore(cfg Config, k8sCli kubernetes.Interface) (Store, error) {
+	switch {
+	case cfg.ConfigMap.Name != "":
+		if k8sCli == nil {
+			return nil, errors.New("the kubeconfig is required to store checkpoints in a ConfigMap")
+		}
+		return &ConfigMapStore{cfg: cfg, k8sCli: k8sCli}, nil
+	case cfg.FileName != "":
+		return &FileStore{fileName: cfg.FileName}, nil
+	default:
+		return nil, errors.New("either the file name or the ConfigMap name must be specified")
+	}
+}
+
+// FileStore keeps the checkpoint in a local JSON file.
+type FileStore struct {
+	fileName string
+}
+
+// Load returns the stored checkpoint. An empty checkpoint is returned if the file doesn't exist.
+func (s *FileStore) Load(_ context.Context) (Checkpoint, error) {
+	raw, err := os.ReadFile(filepath.Clean(s.fileName))
+	switch {
+	case err == nil:
+	case errors.Is(err, os.ErrNotExist):
+		return Checkpoint{}, nil
+	default:
+		return Checkpoint{}, fmt.Errorf("while reading checkpoint file: %w", err)
+	}
+
+	return unmarshalCheckpoint(raw)
+}
+
+// Save stores a given checkpoint.
+func (s *FileStore) Save(_ context.Context, checkpoint Checkpoint) error {
+	raw, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("while marshaling checkpoint: %w", err)
+	}
+
+	if err := os.WriteFile(s.fileName, raw, 0o600); err != nil {
+		return fmt.Errorf("while writing checkpoint file: %w", err)
+	}
+	return nil
+}
+
+// ConfigMapStore keeps the checkpoint under a given key in a Kubernetes ConfigMap.
+// Multiple sources can share the same ConfigMap as long as they use different keys.
+type ConfigMapStore struct {
+	cfg    Config
+	k8sCli kubernetes.Interface
+}
+
+// Load returns the stored checkpoint. An empty checkpoint is returned if the ConfigMap or the key doesn't exist.
+func (s *ConfigMapStore) Load(ctx context.Context) (Checkpoint, error) {
+	ref := s.cfg.ConfigMap
+	cm, err := s.k8sCli.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		return Checkpoint{}, nil
+	default:
+		return Checkpoint{}, fmt.Errorf("while getting the checkpoint ConfigMap: %w", err)
+	}
+
+	return unmarshalCheckpoint([]byte(cm.Data[s.cfg.Key]))
+}
+
+// Save stores a given checkpoint.
+func (s *ConfigMapStore) Save(ctx context.Context, checkpoint Checkpoint) error {
+	raw, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("while marshaling checkpoint: %w", err)
+	}
+
+	ref := s.cfg.ConfigMap
+	cm, err := s.k8sCli.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ref.Name,
+				Namespace: ref.Namespace,
+			},
+			Data: map[string]string{
+				s.cfg.Key: string(raw),
+			},
+		}
+		_, err = s.k8sCli.CoreV1().ConfigMaps(ref.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("while creating the checkpoint ConfigMap: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("while getting the checkpoint ConfigMap: %w", err)
+	}
+
+	newCM := cm.DeepCopy()
+	if newCM.Data == nil {
+		newCM.Data = map[string]string{}
+	}
+	newCM.Data[s.cfg.Key] = string(raw)
+
+	_, err = s.k8sCli.CoreV1().ConfigMaps(ref.Namespace).Update(ctx, newCM, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("while updating the checkpoint ConfigMap: %w", err)
+	}
+	return nil
+}
+
+func unmarshalCheckpoint(raw []byte) (Checkpoint, error) {
+	if len(raw) == 0 {
+		return Checkpoint{}, nil
+	}
+
+	var out Checkpoint
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return Checkpoint{}, fmt.Errorf("while unmarshaling checkpoint: %w", err)
+	}
+	return out, nil
+}
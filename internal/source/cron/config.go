@@ -0,0 +1,45 @@
+package cron
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+// Config holds cron source configuration.
+type Config struct {
+	// Schedules holds schedules indexed by their names.
+	Schedules map[string]Schedule `yaml:"schedules"`
+	Log       config.Logger       `yaml:"log"`
+}
+
+// Schedule defines when events are emitted.
+type Schedule struct {
+	// Cron is a standard cron expression, e.g. `0 9 * * 1-5`. Descriptors such as `@hourly` or `@every 1h30m` are also supported.
+	Cron string `yaml:"cron"`
+	// Timezone is an IANA Time Zone name, e.g. `Europe/Warsaw`. Defaults to UTC.
+	Timezone string `yaml:"timezone"`
+	// Jitter is the max random delay added to each run. It should be lower than the schedule interval.
+	Jitter time.Duration `yaml:"jitter"`
+	// Message is posted to the bound channels when the schedule is triggered. If empty, only the bound actions are executed.
+	Message string `yaml:"message"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Log: config.Logger{
+			Level: "info",
+		},
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
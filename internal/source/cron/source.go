@@ -0,0 +1,273 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+const (
+	// PluginName is the name of the cron Botkube plugin.
+	PluginName = "cron"
+
+	description = "Emits events on configured cron schedules. Use it together with actions to post periodic reports."
+)
+
+// Event is emitted when a given schedule is triggered. It is available as `{{ .Event }}` in the action command templates.
+type Event struct {
+	Schedule    string
+	Cron        string
+	Timezone    string
+	TriggeredAt time.Time
+}
+
+// Source cron source plugin data structure
+type Source struct {
+	pluginVersion string
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+	}
+}
+
+// Stream emits events on configured schedules.
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+
+	schedules, err := parseSchedules(cfg.Schedules)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while parsing schedules: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	out := source.StreamOutput{Event: make(chan source.Event)}
+	for _, sched := range schedules {
+		go sched.run(ctx, log.WithField("schedule", sched.name), out.Event)
+	}
+
+	return out, nil
+}
+
+// Metadata returns metadata of cron configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+type schedule struct {
+	name     string
+	cfg      Schedule
+	spec     cron.Schedule
+	location *time.Location
+	// randInt63n returns a random number in [0,n). It's used to calculate jitter.
+	randInt63n func(n int64) int64
+}
+
+func parseSchedules(in map[string]Schedule) ([]schedule, error) {
+	if len(in) == 0 {
+		return nil, errors.New("at least one schedule needs to be specified")
+	}
+
+	var (
+		out    []schedule
+		issues = multierror.New()
+	)
+	for name, cfg := range in {
+		spec, err := cron.ParseStandard(cfg.Cron)
+		if err != nil {
+			issues = multierror.Append(issues, fmt.Errorf("invalid cron expression %q for schedule %q: %w", cfg.Cron, name, err))
+			continue
+		}
+
+		location, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			issues = multierror.Append(issues, fmt.Errorf("invalid timezone %q for schedule %q: %w", cfg.Timezone, name, err))
+			continue
+		}
+
+		if cfg.Jitter < 0 {
+			issues = multierror.Append(issues, fmt.Errorf("jitter for schedule %q cannot be negative", name))
+			continue
+		}
+
+		out = append(out, schedule{
+			name:       name,
+			cfg:        cfg,
+			spec:       spec,
+			location:   location,
+			randInt63n: rand.Int63n,
+		})
+	}
+	if err := issues.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].name < out[j].name
+	})
+	return out, nil
+}
+
+// nextRun returns the next activation time after a given time, including the random jitter.
+func (s schedule) nextRun(now time.Time) time.Time {
+	next := s.spec.Next(now.In(s.location))
+	if s.cfg.Jitter > 0 {
+		next = next.Add(time.Duration(s.randInt63n(int64(s.cfg.Jitter))))
+	}
+	return next
+}
+
+func (s schedule) run(ctx context.Context, log logrus.FieldLogger, ch chan<- source.Event) {
+	for {
+		next := s.nextRun(time.Now())
+		log.Debugf("Next run scheduled at %s", next)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case ch <- s.event(time.Now()):
+		}
+	}
+}
+
+func (s schedule) event(triggeredAt time.Time) source.Event {
+	var msg api.Message
+	if s.cfg.Message != "" {
+		msg = api.NewPlaintextMessage(s.cfg.Message, false)
+	}
+
+	return source.Event{
+		Message: msg,
+		RawObject: Event{
+			Schedule:    s.name,
+			Cron:        s.cfg.Cron,
+			Timezone:    s.location.String(),
+			TriggeredAt: triggeredAt.In(s.location),
+		},
+	}
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Cron",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"schedules": {
+			  "title": "Schedules",
+			  "description": "Schedules indexed by their names.",
+			  "type": "object",
+			  "minProperties": 1,
+			  "additionalProperties": {
+				"type": "object",
+				"properties": {
+				  "cron": {
+					"title": "Cron expression",
+					"description": "Standard cron expression, e.g. '0 9 * * 1-5'. Descriptors such as '@hourly' or '@every 1h30m' are also supported.",
+					"type": "string"
+				  },
+				  "timezone": {
+					"title": "Timezone",
+					"description": "IANA Time Zone name, e.g. 'Europe/Warsaw'. Defaults to UTC.",
+					"type": "string",
+					"default": "UTC"
+				  },
+				  "jitter": {
+					"title": "Jitter",
+					"description": "Max random delay added to each run, e.g. '5m'. It should be lower than the schedule interval.",
+					"type": "string"
+				  },
+				  "message": {
+					"title": "Message",
+					"description": "Message posted to the bound channels when the schedule is triggered. If empty, only the bound actions are executed.",
+					"type": "string"
+				  }
+				},
+				"required": ["cron"]
+			  }
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  },
+		  "required": ["schedules"]
+		}`, description),
+	}
+}
@@ -0,0 +1,110 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleNextRun(t *testing.T) {
+	// given
+	schedules, err := parseSchedules(map[string]Schedule{
+		"morning-warsaw": {
+			Cron:     "0 9 * * 1-5",
+			Timezone: "Europe/Warsaw",
+		},
+		"morning-utc": {
+			Cron:   "0 9 * * 1-5",
+			Jitter: 10 * time.Minute,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+
+	utcSchedule, warsawSchedule := schedules[0], schedules[1]
+	utcSchedule.randInt63n = func(n int64) int64 {
+		assert.Equal(t, int64(10*time.Minute), n)
+		return int64(3 * time.Minute)
+	}
+
+	// Friday, 10:00 UTC
+	now := time.Date(2023, 7, 7, 10, 0, 0, 0, time.UTC)
+
+	// when
+	utcNext := utcSchedule.nextRun(now)
+	warsawNext := warsawSchedule.nextRun(now)
+
+	// then
+	assert.Equal(t, time.Date(2023, 7, 10, 9, 3, 0, 0, time.UTC), utcNext.UTC())
+	assert.Equal(t, time.Date(2023, 7, 10, 7, 0, 0, 0, time.UTC), warsawNext.UTC())
+}
+
+func TestScheduleEvent(t *testing.T) {
+	// given
+	schedules, err := parseSchedules(map[string]Schedule{
+		"silent": {Cron: "@hourly"},
+		"report": {Cron: "@daily", Timezone: "America/New_York", Message: "Daily report"},
+	})
+	require.NoError(t, err)
+	triggeredAt := time.Date(2023, 7, 7, 4, 0, 0, 0, time.UTC)
+
+	// when
+	report := schedules[0].event(triggeredAt)
+	silent := schedules[1].event(triggeredAt)
+
+	// then
+	assert.Equal(t, "Daily report", report.Message.BaseBody.Plaintext)
+	assert.Equal(t, Event{
+		Schedule:    "report",
+		Cron:        "@daily",
+		Timezone:    "America/New_York",
+		TriggeredAt: triggeredAt.In(schedules[0].location),
+	}, report.RawObject)
+
+	assert.True(t, silent.Message.IsEmpty())
+}
+
+func TestParseSchedulesErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		schedules   map[string]Schedule
+		expErrorMsg string
+	}{
+		{
+			name:        "No schedules",
+			expErrorMsg: "at least one schedule needs to be specified",
+		},
+		{
+			name: "Invalid cron expression",
+			schedules: map[string]Schedule{
+				"report": {Cron: "every morning"},
+			},
+			expErrorMsg: "1 error occurred:\n\t* invalid cron expression \"every morning\" for schedule \"report\": expected exactly 5 fields, found 2: [every morning]",
+		},
+		{
+			name: "Invalid timezone",
+			schedules: map[string]Schedule{
+				"report": {Cron: "@daily", Timezone: "Mars/Olympus_Mons"},
+			},
+			expErrorMsg: "1 error occurred:\n\t* invalid timezone \"Mars/Olympus_Mons\" for schedule \"report\": unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			name: "Negative jitter",
+			schedules: map[string]Schedule{
+				"report": {Cron: "@daily", Jitter: -time.Second},
+			},
+			expErrorMsg: "1 error occurred:\n\t* jitter for schedule \"report\" cannot be negative",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := parseSchedules(tc.schedules)
+
+			// then
+			assert.EqualError(t, err, tc.expErrorMsg)
+		})
+	}
+}
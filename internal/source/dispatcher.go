@@ -11,8 +11,11 @@ import (
 
 	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/delivery"
+	"github.com/kubeshop/botkube/internal/metrics"
 	"github.com/kubeshop/botkube/internal/plugin"
 	"github.com/kubeshop/botkube/pkg/action"
+	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/source"
 	"github.com/kubeshop/botkube/pkg/bot"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
@@ -28,11 +31,25 @@ type Dispatcher struct {
 	actionProvider       ActionProvider
 	reporter             AnalyticsReporter
 	auditReporter        audit.AuditReporter
-	markdownNotifiers    []notifier.Bot
-	interactiveNotifiers []notifier.Bot
-	sinkNotifiers        []notifier.Sink
+	markdownNotifiers    map[string]notifier.Bot
+	interactiveNotifiers map[string]notifier.Bot
+	sinkNotifiers        map[string]notifier.Sink
+	deliveryQueue        DeliveryQueue
 	restCfg              *rest.Config
 	clusterName          string
+	notifications        NotificationSuppressor
+}
+
+// NotificationSuppressor decides whether notifications for a given key are snoozed or silenced.
+// It also remembers sent messages, so they can be edited once acknowledged.
+type NotificationSuppressor interface {
+	IsSuppressed(key string) bool
+	RememberMessage(key string, msg api.Message) api.Message
+}
+
+// DeliveryQueue delivers notifications to bot and sink notifiers.
+type DeliveryQueue interface {
+	Enqueue(ctx context.Context, item delivery.Item, onResult delivery.ResultFn)
 }
 
 // ActionProvider defines a provider that is responsible for automated actions.
@@ -57,18 +74,18 @@ type AnalyticsReporter interface {
 }
 
 // NewDispatcher create a new Dispatcher instance.
-func NewDispatcher(log logrus.FieldLogger, clusterName string, notifiers map[string]bot.Bot, sinkNotifiers []notifier.Sink, manager *plugin.Manager, actionProvider ActionProvider, reporter AnalyticsReporter, auditReporter audit.AuditReporter, restCfg *rest.Config) *Dispatcher {
+func NewDispatcher(log logrus.FieldLogger, clusterName string, notifiers map[string]bot.Bot, sinkNotifiers map[string]notifier.Sink, manager *plugin.Manager, actionProvider ActionProvider, reporter AnalyticsReporter, auditReporter audit.AuditReporter, deliveryQueue DeliveryQueue, restCfg *rest.Config, notifications NotificationSuppressor) *Dispatcher {
 	var (
-		interactiveNotifiers []notifier.Bot
-		markdownNotifiers    []notifier.Bot
+		interactiveNotifiers = map[string]notifier.Bot{}
+		markdownNotifiers    = map[string]notifier.Bot{}
 	)
-	for _, n := range notifiers {
+	for key, n := range notifiers {
 		if n.IntegrationName().IsInteractive() {
-			interactiveNotifiers = append(interactiveNotifiers, n)
+			interactiveNotifiers[key] = n
 			continue
 		}
 
-		markdownNotifiers = append(markdownNotifiers, n)
+		markdownNotifiers[key] = n
 	}
 
 	return &Dispatcher{
@@ -80,8 +97,10 @@ func NewDispatcher(log logrus.FieldLogger, clusterName string, notifiers map[str
 		interactiveNotifiers: interactiveNotifiers,
 		markdownNotifiers:    markdownNotifiers,
 		sinkNotifiers:        sinkNotifiers,
+		deliveryQueue:        deliveryQueue,
 		restCfg:              restCfg,
 		clusterName:          clusterName,
+		notifications:        notifications,
 	}
 }
 
@@ -119,15 +138,24 @@ func (d *Dispatcher) Dispatch(dispatch PluginDispatch) error {
 		return fmt.Errorf(`while opening stream for "%s.%s" source: %w`, dispatch.sourceName, dispatch.pluginName, err)
 	}
 
+	throttler, err := d.newThrottler(log, dispatch)
+	if err != nil {
+		return fmt.Errorf(`while creating event throttler for "%s.%s" source: %w`, dispatch.sourceName, dispatch.pluginName, err)
+	}
+
 	go func() {
+		if throttler != nil {
+			defer throttler.Stop()
+		}
 		for {
 			select {
 			case msg, ok := <-out.Event:
 				if !ok {
 					return
 				}
+				metrics.ReportSourceEvent(dispatch.pluginName, dispatch.sourceName)
 				log.WithField("message", msg).Debug("Dispatching received message...")
-				d.dispatchMsg(ctx, msg, dispatch)
+				d.dispatchMsg(ctx, msg, dispatch, throttler)
 			case <-ctx.Done():
 				return
 			}
@@ -136,62 +164,135 @@ func (d *Dispatcher) Dispatch(dispatch PluginDispatch) error {
 	return nil
 }
 
-func (d *Dispatcher) getBotNotifiers(dispatch PluginDispatch) []notifier.Bot {
+func (d *Dispatcher) newThrottler(log logrus.FieldLogger, dispatch PluginDispatch) (*eventThrottler, error) {
+	throttlingCfg := dispatch.cfg.Sources[dispatch.sourceName].Throttling
+	if !throttlingCfg.Enabled {
+		return nil, nil
+	}
+
+	return newEventThrottler(log, throttlingCfg, func(key string, suppressed int) {
+		d.dispatchRollup(dispatch, key, suppressed, throttlingCfg.Window)
+	}, func(channel throttledChannel, suppressed int) {
+		d.dispatchBudgetRollup(dispatch, channel, suppressed, throttlingCfg.MaxMessagesPerMinute)
+	})
+}
+
+func (d *Dispatcher) dispatchRollup(dispatch PluginDispatch, key string, suppressed int, window time.Duration) {
+	ctx := dispatch.ctx
+	if ctx.Err() != nil {
+		return
+	}
+
+	msg := interactive.CoreMessage{
+		Message: api.NewPlaintextMessage(fmt.Sprintf("Suppressed %d similar events (%s) from %q source in the last %s.", suppressed, key, d.sourceDisplayName(dispatch), window), false),
+	}
+	d.sendBotMessage(ctx, dispatch, msg, func(err error) {
+		if err != nil {
+			d.log.Errorf("while sending suppressed events summary message: %s", err.Error())
+		}
+	})
+}
+
+func (d *Dispatcher) dispatchBudgetRollup(dispatch PluginDispatch, channel throttledChannel, suppressed, limit int) {
+	ctx := dispatch.ctx
+	if ctx.Err() != nil {
+		return
+	}
+
+	msg := interactive.CoreMessage{
+		Message: api.NewPlaintextMessage(fmt.Sprintf("Suppressed %d events from %q source, as the limit of %d messages per minute was exceeded.", suppressed, d.sourceDisplayName(dispatch), limit), false),
+	}
+	d.deliveryQueue.Enqueue(ctx, delivery.Item{
+		NotifierKey: channel.NotifierKey,
+		PluginName:  dispatch.pluginName,
+		Sources:     []string{dispatch.sourceName},
+		Channels:    []string{channel.Channel},
+		Message:     &msg,
+	}, func(err error) {
+		if err != nil {
+			d.log.Errorf("while sending suppressed events summary message: %s", err.Error())
+		}
+	})
+}
+
+func (d *Dispatcher) sourceDisplayName(dispatch PluginDispatch) string {
+	if dispatch.sourceDisplayName == "" {
+		return dispatch.sourceName
+	}
+	return dispatch.sourceDisplayName
+}
+
+func (d *Dispatcher) getBotNotifiers(dispatch PluginDispatch) map[string]notifier.Bot {
 	if dispatch.isInteractivitySupported {
 		return d.interactiveNotifiers
 	}
 	return d.markdownNotifiers
 }
 
-func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispatch PluginDispatch) {
+func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispatch PluginDispatch, throttler *eventThrottler) {
 	var (
 		pluginName = dispatch.pluginName
 		sources    = []string{dispatch.sourceName}
 	)
 
-	for _, n := range d.getBotNotifiers(dispatch) {
-		go func(n notifier.Bot) {
-			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
-			msg := interactive.CoreMessage{
-				Message: event.Message,
-			}
-			err := n.SendMessage(ctx, msg, sources)
-			if err != nil {
-				reportErr := d.reportError(err, n, pluginName, event)
-				if reportErr != nil {
-					err = multierror.Append(err, fmt.Errorf("while reporting error: %w", reportErr))
-				}
+	var correlationKey string
+	if dispatch.cfg.Sources[dispatch.sourceName].Threading.Enabled {
+		correlationKey = event.CorrelationKey
+	}
 
-				d.log.Errorf("while sending bot message: %s", err.Error())
-				return
-			}
+	// Sources may emit events without a message only to trigger actions, e.g. the cron source.
+	botNotifiers := d.getBotNotifiers(dispatch)
+	if event.Message.IsEmpty() {
+		botNotifiers = nil
+	}
 
-			reportErr := d.reportSuccess(n, pluginName, event)
-			if reportErr != nil {
-				d.log.Error(err)
-			}
-		}(n)
+	// Snoozed and silenced events are not sent to communication platforms. Sinks and actions are not affected.
+	if event.NotificationKey != "" && d.notifications != nil {
+		if d.notifications.IsSuppressed(event.NotificationKey) {
+			d.log.WithField("key", event.NotificationKey).Debug("Notification is snoozed or silenced. Skipping sending it to bots...")
+			botNotifiers = nil
+		} else if len(botNotifiers) > 0 {
+			event.Message = d.notifications.RememberMessage(event.NotificationKey, event.Message)
+		}
 	}
 
-	for _, n := range d.sinkNotifiers {
-		go func(n notifier.Sink) {
-			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
-			err := n.SendEvent(ctx, event.RawObject, sources)
-			if err != nil {
-				reportErr := d.reportError(err, n, pluginName, event)
-				if reportErr != nil {
-					err = multierror.Append(err, fmt.Errorf("while reporting error: %w", reportErr))
-				}
+	// Throttling limits only messages sent to communication platforms. Sinks, audit and actions get all events.
+	if throttler != nil && len(botNotifiers) > 0 && !throttler.Allow(event.RawObject) {
+		botNotifiers = nil
+	}
 
-				d.log.Errorf("while sending sink message: %s", err.Error())
-				return
+	for key, n := range botNotifiers {
+		var channels []string
+		if throttler != nil && throttler.LimitsChannels() {
+			channels = throttler.ReserveChannels(key, n.ChannelsToNotify(sources))
+			if len(channels) == 0 {
+				continue
 			}
+		}
 
-			reportErr := d.reportSuccess(n, pluginName, event)
-			if reportErr != nil {
-				d.log.Error(err)
-			}
-		}(n)
+		msg := interactive.CoreMessage{
+			Message: event.Message,
+		}
+		d.deliveryQueue.Enqueue(ctx, delivery.Item{
+			NotifierKey:      key,
+			PluginName:       pluginName,
+			Sources:          sources,
+			Channels:         channels,
+			Message:          &msg,
+			AnalyticsLabels:  event.AnalyticsLabels,
+			CorrelationKey:   correlationKey,
+			LivingMessageKey: event.LivingMessageKey,
+		}, d.reportDeliveryResult(n, pluginName, event))
+	}
+
+	for key, n := range d.sinkNotifiers {
+		d.deliveryQueue.Enqueue(ctx, delivery.Item{
+			NotifierKey:     key,
+			PluginName:      pluginName,
+			Sources:         sources,
+			Event:           event.RawObject,
+			AnalyticsLabels: event.AnalyticsLabels,
+		}, d.reportDeliveryResult(n, pluginName, event))
 	}
 
 	if err := d.reportAuditEvent(ctx, pluginName, event.RawObject, dispatch.sourceName, dispatch.sourceDisplayName); err != nil {
@@ -213,14 +314,41 @@ func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispat
 		genericMsg := d.actionProvider.ExecuteAction(ctx, act)
 		log.WithField("message", fmt.Sprintf("%+v", genericMsg)).Debug("Automated action executed. Printing output message...")
 
-		for _, n := range d.getBotNotifiers(dispatch) {
-			go func(n notifier.Bot) {
-				defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
-				err := n.SendMessage(ctx, genericMsg, sources)
-				if err != nil {
-					d.log.Errorf("while sending action result message: %s", err.Error())
-				}
-			}(n)
+		d.sendBotMessage(ctx, dispatch, genericMsg, func(err error) {
+			if err != nil {
+				d.log.Errorf("while sending action result message: %s", err.Error())
+			}
+		})
+	}
+}
+
+func (d *Dispatcher) sendBotMessage(ctx context.Context, dispatch PluginDispatch, msg interactive.CoreMessage, onResult delivery.ResultFn) {
+	for key := range d.getBotNotifiers(dispatch) {
+		d.deliveryQueue.Enqueue(ctx, delivery.Item{
+			NotifierKey: key,
+			PluginName:  dispatch.pluginName,
+			Sources:     []string{dispatch.sourceName},
+			Message:     &msg,
+		}, onResult)
+	}
+}
+
+func (d *Dispatcher) reportDeliveryResult(n genericNotifier, pluginName string, event source.Event) delivery.ResultFn {
+	return func(err error) {
+		metrics.ReportNotification(n.IntegrationName().String(), err)
+		if err != nil {
+			reportErr := d.reportError(err, n, pluginName, event)
+			if reportErr != nil {
+				err = multierror.Append(err, fmt.Errorf("while reporting error: %w", reportErr))
+			}
+
+			d.log.Errorf("while sending %s message: %s", n.Type(), err.Error())
+			return
+		}
+
+		reportErr := d.reportSuccess(n, pluginName, event)
+		if reportErr != nil {
+			d.log.Error(reportErr)
 		}
 	}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/google/go-querystring/query"
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/internal/jsonpathx"
 	"github.com/kubeshop/botkube/internal/source/github_events/templates"
 	"github.com/kubeshop/botkube/pkg/api/source"
 )
@@ -31,7 +32,7 @@ type Watcher struct {
 	lastProcessTime map[string]time.Time
 	repos           map[string]matchCriteria
 	prMatcher       *PullRequestMatcher
-	jsonPathMatcher *JSONPathMatcher
+	jsonPathMatcher *jsonpathx.Matcher
 }
 
 // NewWatcher returns a new Watcher instance.
@@ -48,7 +49,7 @@ func NewWatcher(refreshDuration time.Duration, repositories []RepositoryConfig,
 		cli:             cli,
 		log:             log,
 		prMatcher:       NewPullRequestMatcher(log, cli),
-		jsonPathMatcher: NewJSONPathMatcher(log),
+		jsonPathMatcher: jsonpathx.NewMatcher(log),
 		lastProcessTime: lastProcessTime,
 	}, nil
 }
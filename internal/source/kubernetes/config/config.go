@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/checkpoint"
 	"github.com/kubeshop/botkube/pkg/api/source"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/pluginx"
@@ -25,6 +26,11 @@ type Config struct {
 	Annotations          *map[string]string `yaml:"annotations"`
 	Labels               *map[string]string `yaml:"labels"`
 	Filters              *Filters           `yaml:"filters"`
+	Checkpoint           checkpoint.Config  `yaml:"checkpoint"`
+	Digest               Digest             `yaml:"digest"`
+	LivingMessages       LivingMessages     `yaml:"livingMessages"`
+	// Threading is set by Botkube from the source threading settings. Correlation keys are resolved only when it's enabled.
+	Threading config.EventThreading `yaml:"threading"`
 }
 
 type (
@@ -50,8 +56,14 @@ type Commands struct {
 
 // Recommendations contains configuration for various recommendation insights.
 type Recommendations struct {
-	Ingress IngressRecommendations `yaml:"ingress"`
-	Pod     PodRecommendations     `yaml:"pod"`
+	Ingress    IngressRecommendations    `yaml:"ingress"`
+	Pod        PodRecommendations        `yaml:"pod"`
+	Deployment DeploymentRecommendations `yaml:"deployment"`
+	Service    ServiceRecommendations    `yaml:"service"`
+	HPA        HPARecommendations        `yaml:"hpa"`
+
+	// Custom contains user-defined recommendations.
+	Custom []CustomRecommendation `yaml:"custom,omitempty"`
 }
 
 // IngressRecommendations contains configuration for ingress recommendations.
@@ -70,6 +82,55 @@ type PodRecommendations struct {
 
 	// LabelsSet notifies about Pod resources created without labels.
 	LabelsSet *bool `yaml:"labelsSet,omitempty"`
+
+	// ResourcesSet notifies about Pod containers without resource requests or limits.
+	ResourcesSet *bool `yaml:"resourcesSet,omitempty"`
+
+	// ProbesSet notifies about Pod containers without liveness or readiness probes.
+	ProbesSet *bool `yaml:"probesSet,omitempty"`
+
+	// NoPrivilegedContainers notifies about Pod containers running in privileged mode.
+	NoPrivilegedContainers *bool `yaml:"noPrivilegedContainers,omitempty"`
+
+	// NoHostPathVolumes notifies about Pod resources which mount hostPath volumes.
+	NoHostPathVolumes *bool `yaml:"noHostPathVolumes,omitempty"`
+}
+
+// DeploymentRecommendations contains configuration for deployments recommendations.
+type DeploymentRecommendations struct {
+	// PodDisruptionBudgetSet notifies about single-replica Deployments not covered by any PodDisruptionBudget.
+	PodDisruptionBudgetSet *bool `yaml:"podDisruptionBudgetSet,omitempty"`
+}
+
+// ServiceRecommendations contains configuration for services recommendations.
+type ServiceRecommendations struct {
+	// EndpointsExist notifies about Services which selector doesn't match any Pod within 30 seconds after the Service creation.
+	EndpointsExist *bool `yaml:"endpointsExist,omitempty"`
+}
+
+// HPARecommendations contains configuration for HorizontalPodAutoscaler recommendations.
+type HPARecommendations struct {
+	// ScaleTargetExists notifies about HorizontalPodAutoscalers which target doesn't exist within 30 seconds after the HorizontalPodAutoscaler creation.
+	ScaleTargetExists *bool `yaml:"scaleTargetExists,omitempty"`
+}
+
+// CustomRecommendation contains configuration for a user-defined recommendation.
+type CustomRecommendation struct {
+	// Name of the recommendation.
+	Name string `yaml:"name"`
+
+	// Resource is the resource type the recommendation runs for, e.g. `apps/v1/deployments`.
+	Resource string `yaml:"resource"`
+
+	// Expression is a CEL expression evaluated against the Kubernetes object (`object`).
+	// If it evaluates to true, the Message is added to the event.
+	Expression string `yaml:"expression"`
+
+	// Message is added to the event if the Expression evaluates to true.
+	Message string `yaml:"message"`
+
+	// Severity of the recommendation. For the `error` level, the message is reported as a warning and the event level is raised to `error`.
+	Severity Level `yaml:"severity,omitempty"`
 }
 
 // KubernetesEvent contains configuration for Kubernetes events.
@@ -207,6 +268,35 @@ type Filters struct {
 
 	// NodeEventsChecker filters out Node-related events that are not important.
 	NodeEventsChecker bool `yaml:"nodeEventsChecker"`
+
+	// Expressions are CEL expressions evaluated against the Kubernetes object (`object`) and the event details (`event`).
+	// Events are sent only if all expressions evaluate to true.
+	Expressions []string `yaml:"expressions"`
+}
+
+// Digest contains configuration for the aggregated periodic digest mode.
+// If enabled, matching events are not sent in real time, but summarized in a single message once per window.
+type Digest struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Window is the time over which events are accumulated.
+	Window time.Duration `yaml:"window"`
+
+	// TopN limits the number of the most frequently reported objects listed in the digest.
+	TopN int `yaml:"topN"`
+}
+
+// LivingMessages contains configuration for messages which are edited in place.
+// If enabled, the first event for a given object posts a message, and subsequent events edit it and extend its status timeline.
+// It's supported only on communication platforms which can edit messages, such as Slack, Mattermost and Discord.
+type LivingMessages struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Kinds lists object kinds, e.g. Deployment, for which messages are edited in place.
+	Kinds []string `yaml:"kinds"`
+
+	// TimelineLength limits the number of the most recent status changes shown in the message.
+	TimelineLength int `yaml:"timelineLength"`
 }
 
 // MergeConfigs merges all input configuration.
@@ -218,13 +308,26 @@ func MergeConfigs(configs []*source.Config) (Config, error) {
 		InformerResyncPeriod: 30 * time.Minute,
 		Recommendations: &Recommendations{
 			Pod: PodRecommendations{
-				NoLatestImageTag: ptr.FromType(false),
-				LabelsSet:        ptr.FromType(false),
+				NoLatestImageTag:       ptr.FromType(false),
+				LabelsSet:              ptr.FromType(false),
+				ResourcesSet:           ptr.FromType(false),
+				ProbesSet:              ptr.FromType(false),
+				NoPrivilegedContainers: ptr.FromType(false),
+				NoHostPathVolumes:      ptr.FromType(false),
 			},
 			Ingress: IngressRecommendations{
 				BackendServiceValid: ptr.FromType(false),
 				TLSSecretValid:      ptr.FromType(false),
 			},
+			Deployment: DeploymentRecommendations{
+				PodDisruptionBudgetSet: ptr.FromType(false),
+			},
+			Service: ServiceRecommendations{
+				EndpointsExist: ptr.FromType(false),
+			},
+			HPA: HPARecommendations{
+				ScaleTargetExists: ptr.FromType(false),
+			},
 		},
 		Commands: Commands{
 			Verbs:     []string{"api-resources", "api-versions", "cluster-info", "describe", "explain", "get", "logs", "top"},
@@ -234,6 +337,19 @@ func MergeConfigs(configs []*source.Config) (Config, error) {
 			ObjectAnnotationChecker: true,
 			NodeEventsChecker:       true,
 		},
+		Checkpoint: checkpoint.Config{
+			Key:           "kubernetes",
+			MaxAge:        time.Hour,
+			FlushInterval: time.Second,
+		},
+		Digest: Digest{
+			Window: time.Hour,
+			TopN:   5,
+		},
+		LivingMessages: LivingMessages{
+			Kinds:          []string{"Deployment", "Job"},
+			TimelineLength: 10,
+		},
 	}
 	var out Config
 	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
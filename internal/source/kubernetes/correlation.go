@@ -0,0 +1,143 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+// ownerChainMaxDepth limits the number of followed owner references, e.g. Pod -> ReplicaSet -> Deployment.
+const ownerChainMaxDepth = 3
+
+type objectRef struct {
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+}
+
+func (r objectRef) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.APIVersion, r.Kind, r.Namespace, r.Name)
+}
+
+// CorrelationKeyResolver resolves correlation keys for Kubernetes events. Events for objects with the same top-level owner
+// get the same key, e.g. events for a given Deployment, its ReplicaSets and Pods.
+// Owners are read from informer caches, so resolving keys doesn't call the Kubernetes API server on the event path.
+type CorrelationKeyResolver struct {
+	log             logrus.FieldLogger
+	informerFactory dynamicinformer.DynamicSharedInformerFactory
+	mapper          meta.RESTMapper
+	stopCh          <-chan struct{}
+}
+
+// NewCorrelationKeyResolver returns a new CorrelationKeyResolver instance. Informers for owner kinds are registered
+// in a given factory on first use, and they are stopped once the stopCh is closed.
+func NewCorrelationKeyResolver(log logrus.FieldLogger, informerFactory dynamicinformer.DynamicSharedInformerFactory, mapper meta.RESTMapper, stopCh <-chan struct{}) *CorrelationKeyResolver {
+	return &CorrelationKeyResolver{
+		log:             log,
+		informerFactory: informerFactory,
+		mapper:          mapper,
+		stopCh:          stopCh,
+	}
+}
+
+// Resolve returns the correlation key for a given event.
+func (r *CorrelationKeyResolver) Resolve(ctx context.Context, e event.Event) string {
+	ref := objectRef{
+		APIVersion: e.APIVersion,
+		Kind:       e.Kind,
+		Namespace:  e.Namespace,
+		Name:       e.Name,
+	}
+
+	// For Kubernetes Events, the object meta belongs to the Event itself, not to the involved object.
+	var owner *objectRef
+	isK8sEvent := k8sutil.GetObjectTypeMetaData(e.Object).Kind == "Event"
+	if !isK8sEvent {
+		owner = controllerRef(ref.Namespace, e.ObjectMeta.OwnerReferences)
+	}
+
+	for i := 0; i < ownerChainMaxDepth; i++ {
+		if i > 0 || isK8sEvent {
+			var err error
+			owner, err = r.getOwner(ctx, ref)
+			if err != nil {
+				r.log.WithError(err).Debugf("Cannot get owner of %s. Using it as the correlation key.", ref)
+				break
+			}
+		}
+		if owner == nil {
+			break
+		}
+		ref = *owner
+	}
+
+	return ref.String()
+}
+
+func (r *CorrelationKeyResolver) getOwner(ctx context.Context, ref objectRef) (*objectRef, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing API version: %w", err)
+	}
+	mapping, err := r.mapper.RESTMapping(gv.WithKind(ref.Kind).GroupKind(), gv.Version)
+	if err != nil {
+		return nil, fmt.Errorf("while getting REST mapping: %w", err)
+	}
+
+	informer := r.informerFactory.ForResource(mapping.Resource)
+	// starts only informers which were not started yet
+	r.informerFactory.Start(r.stopCh)
+	if !cache.WaitForCacheSync(ctx.Done(), informer.Informer().HasSynced) {
+		return nil, errors.New("while waiting for informer cache to sync")
+	}
+
+	var obj runtime.Object
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		obj, err = informer.Lister().ByNamespace(ref.Namespace).Get(ref.Name)
+	} else {
+		obj, err = informer.Lister().Get(ref.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("while getting object from cache: %w", err)
+	}
+
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, fmt.Errorf("while getting object metadata: %w", err)
+	}
+	return controllerRef(ref.Namespace, objMeta.GetOwnerReferences()), nil
+}
+
+// controllerRef returns the managing controller of an object. If there is no controller, the first owner is returned.
+func controllerRef(namespace string, refs []metaV1.OwnerReference) *objectRef {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	owner := refs[0]
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller {
+			owner = ref
+			break
+		}
+	}
+
+	return &objectRef{
+		APIVersion: owner.APIVersion,
+		Kind:       owner.Kind,
+		Namespace:  namespace,
+		Name:       owner.Name,
+	}
+}
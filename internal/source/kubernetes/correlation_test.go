@@ -0,0 +1,147 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+func TestCorrelationKeyResolver(t *testing.T) {
+	// given
+	rs := &appsv1.ReplicaSet{
+		TypeMeta: metaV1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:            "nginx-7c5ddbdf54",
+			Namespace:       "default",
+			OwnerReferences: []metaV1.OwnerReference{ownerRef("apps/v1", "Deployment", "nginx")},
+		},
+	}
+	pod := &coreV1.Pod{
+		TypeMeta: metaV1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:            "nginx-7c5ddbdf54-x2f4k",
+			Namespace:       "default",
+			OwnerReferences: []metaV1.OwnerReference{ownerRef("apps/v1", "ReplicaSet", "nginx-7c5ddbdf54")},
+		},
+	}
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: "default"},
+	}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+
+	resolver := fixCorrelationKeyResolver(t, mapper, rs, pod, deploy)
+
+	tests := []struct {
+		name  string
+		event event.Event
+	}{
+		{
+			name:  "Deployment update",
+			event: fixCorrelationEvent(deploy.TypeMeta, deploy.ObjectMeta, deploy),
+		},
+		{
+			name:  "ReplicaSet scale",
+			event: fixCorrelationEvent(rs.TypeMeta, rs.ObjectMeta, rs),
+		},
+		{
+			name:  "Pod update",
+			event: fixCorrelationEvent(pod.TypeMeta, pod.ObjectMeta, pod),
+		},
+		{
+			name: "Pod error event",
+			event: event.Event{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       "nginx-7c5ddbdf54-x2f4k",
+				Namespace:  "default",
+				// object meta of the Kubernetes Event, not the involved Pod
+				ObjectMeta: metaV1.ObjectMeta{Name: "nginx-7c5ddbdf54-x2f4k.1771c3a3c", Namespace: "default"},
+				Object: &unstructured.Unstructured{
+					Object: map[string]interface{}{"apiVersion": "v1", "kind": "Event"},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			key := resolver.Resolve(context.Background(), tc.event)
+
+			// then
+			assert.Equal(t, "apps/v1/Deployment/default/nginx", key)
+		})
+	}
+}
+
+func TestCorrelationKeyResolverWithoutOwner(t *testing.T) {
+	// given
+	mapper := meta.NewDefaultRESTMapper(nil)
+	resolver := fixCorrelationKeyResolver(t, mapper)
+
+	svc := &coreV1.Service{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "nginx", Namespace: "default"},
+	}
+
+	// when
+	key := resolver.Resolve(context.Background(), fixCorrelationEvent(svc.TypeMeta, svc.ObjectMeta, svc))
+
+	// then
+	assert.Equal(t, "v1/Service/default/nginx", key)
+}
+
+func fixCorrelationKeyResolver(t *testing.T, mapper meta.RESTMapper, objects ...runtime.Object) *CorrelationKeyResolver {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	dynamicCli := fake.NewSimpleDynamicClientWithCustomListKinds(scheme.Scheme, map[schema.GroupVersionResource]string{
+		{Group: "apps", Version: "v1", Resource: "replicasets"}: "ReplicaSetList",
+		{Group: "apps", Version: "v1", Resource: "deployments"}: "DeploymentList",
+		{Version: "v1", Resource: "pods"}:                       "PodList",
+	}, objects...)
+	informerFactory := dynamicinformer.NewDynamicSharedInformerFactory(dynamicCli, 0)
+
+	return NewCorrelationKeyResolver(loggerx.NewNoop(), informerFactory, mapper, ctx.Done())
+}
+
+func fixCorrelationEvent(typeMeta metaV1.TypeMeta, objectMeta metaV1.ObjectMeta, obj interface{}) event.Event {
+	return event.Event{
+		APIVersion: typeMeta.APIVersion,
+		Kind:       typeMeta.Kind,
+		Name:       objectMeta.Name,
+		Namespace:  objectMeta.Namespace,
+		ObjectMeta: objectMeta,
+		Object:     obj,
+	}
+}
+
+func ownerRef(apiVersion, kind, name string) metaV1.OwnerReference {
+	return metaV1.OwnerReference{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       name,
+		Controller: pointer.Bool(true),
+	}
+}
@@ -0,0 +1,173 @@
+package kubernetes
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+// DigestGroup describes the number of events with the same namespace, kind, reason and level.
+type DigestGroup struct {
+	Namespace string
+	Kind      string
+	Reason    string
+	Level     config.Level
+	Count     int
+}
+
+// DigestOffender describes the number of events reported for a given object.
+type DigestOffender struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Count     int
+}
+
+// DigestSummary holds events accumulated in a given time window.
+type DigestSummary struct {
+	Since        time.Time
+	Until        time.Time
+	Total        int
+	Groups       []DigestGroup
+	TopOffenders []DigestOffender
+
+	// lastTimestamp and lastKeys describe the newest summarized events. They are recorded in the checkpoint once the digest is sent.
+	lastTimestamp time.Time
+	lastKeys      []string
+}
+
+type digestGroupKey struct {
+	namespace string
+	kind      string
+	reason    string
+	level     config.Level
+}
+
+type digestObjectKey struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// Digest accumulates events and summarizes them periodically.
+// Events are not kept, only counters per group and object, and the newest events needed for the checkpoint.
+type Digest struct {
+	topN int
+
+	mu            sync.Mutex
+	since         time.Time
+	total         int
+	groups        map[digestGroupKey]int
+	objects       map[digestObjectKey]int
+	lastTimestamp time.Time
+	lastKeys      []string
+}
+
+// NewDigest returns a new Digest instance.
+func NewDigest(topN int, since time.Time) *Digest {
+	return &Digest{
+		topN:    topN,
+		since:   since,
+		groups:  map[digestGroupKey]int{},
+		objects: map[digestObjectKey]int{},
+	}
+}
+
+// Add adds a given event to the digest.
+func (d *Digest) Add(e event.Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.total++
+	d.groups[digestGroupKey{namespace: e.Namespace, kind: e.Kind, reason: e.Reason, level: e.Level}]++
+	d.objects[digestObjectKey{kind: e.Kind, namespace: e.Namespace, name: e.Name}]++
+
+	// the checkpoint tracks only the newest events, so older ones don't need to be kept
+	key := checkpointKey(e)
+	switch {
+	case e.TimeStamp.After(d.lastTimestamp):
+		d.lastTimestamp = e.TimeStamp
+		d.lastKeys = []string{key}
+	case e.TimeStamp.Equal(d.lastTimestamp) && !slices.Contains(d.lastKeys, key):
+		d.lastKeys = append(d.lastKeys, key)
+	}
+}
+
+// Flush returns the summary of events accumulated since the last flush and resets the digest.
+func (d *Digest) Flush(until time.Time) DigestSummary {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	out := DigestSummary{
+		Since:         d.since,
+		Until:         until,
+		Total:         d.total,
+		lastTimestamp: d.lastTimestamp,
+		lastKeys:      d.lastKeys,
+	}
+
+	for key, count := range d.groups {
+		out.Groups = append(out.Groups, DigestGroup{
+			Namespace: key.namespace,
+			Kind:      key.kind,
+			Reason:    key.reason,
+			Level:     key.level,
+			Count:     count,
+		})
+	}
+	sort.Slice(out.Groups, func(i, j int) bool {
+		a, b := out.Groups[i], out.Groups[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Reason != b.Reason {
+			return a.Reason < b.Reason
+		}
+		return a.Level < b.Level
+	})
+
+	for key, count := range d.objects {
+		out.TopOffenders = append(out.TopOffenders, DigestOffender{
+			Kind:      key.kind,
+			Namespace: key.namespace,
+			Name:      key.name,
+			Count:     count,
+		})
+	}
+	sort.Slice(out.TopOffenders, func(i, j int) bool {
+		a, b := out.TopOffenders[i], out.TopOffenders[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	if d.topN > 0 && len(out.TopOffenders) > d.topN {
+		out.TopOffenders = out.TopOffenders[:d.topN]
+	}
+
+	d.since = until
+	d.total = 0
+	d.groups = map[digestGroupKey]int{}
+	d.objects = map[digestObjectKey]int{}
+	d.lastTimestamp = time.Time{}
+	d.lastKeys = nil
+
+	return out
+}
@@ -0,0 +1,110 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestDigestFlush(t *testing.T) {
+	// given
+	since := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	until := since.Add(time.Hour)
+	digest := NewDigest(2, since)
+
+	backOff := event.Event{Kind: "Pod", Namespace: "default", Name: "foo", Reason: "BackOff", Level: config.Error, TimeStamp: since.Add(time.Minute)}
+	newest := since.Add(2 * time.Minute)
+	digest.Add(backOff)
+	digest.Add(event.Event{Kind: "Pod", Namespace: "default", Name: "bar", Reason: "BackOff", Level: config.Error, TimeStamp: newest})
+	digest.Add(backOff)
+	digest.Add(event.Event{Kind: "Node", Name: "node-1", Reason: "NodeNotReady", Level: config.Error, TimeStamp: newest})
+
+	// when
+	summary := digest.Flush(until)
+
+	// then
+	assert.Equal(t, since, summary.Since)
+	assert.Equal(t, until, summary.Until)
+	assert.Equal(t, 4, summary.Total)
+	assert.Equal(t, []DigestGroup{
+		{Namespace: "default", Kind: "Pod", Reason: "BackOff", Level: config.Error, Count: 3},
+		{Namespace: "", Kind: "Node", Reason: "NodeNotReady", Level: config.Error, Count: 1},
+	}, summary.Groups)
+	assert.Equal(t, []DigestOffender{
+		{Kind: "Pod", Namespace: "default", Name: "foo", Count: 2},
+		{Kind: "Node", Namespace: "", Name: "node-1", Count: 1},
+	}, summary.TopOffenders)
+	assert.Equal(t, newest, summary.lastTimestamp)
+	assert.Equal(t, []string{"//default/bar/BackOff", "///node-1/NodeNotReady"}, summary.lastKeys)
+
+	// when
+	next := digest.Flush(until.Add(time.Hour))
+
+	// then
+	assert.Equal(t, until, next.Since)
+	assert.Zero(t, next.Total)
+	assert.Empty(t, next.Groups)
+}
+
+func TestMessageBuilderFromDigest(t *testing.T) {
+	// given
+	since := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	summary := DigestSummary{
+		Since: since,
+		Until: since.Add(time.Hour),
+		Total: 3,
+		Groups: []DigestGroup{
+			{Namespace: "default", Kind: "Pod", Reason: "BackOff", Level: config.Error, Count: 2},
+			{Kind: "Node", Reason: "NodeNotReady", Level: config.Error, Count: 1},
+		},
+		TopOffenders: []DigestOffender{
+			{Kind: "Pod", Namespace: "default", Name: "foo", Count: 2},
+			{Kind: "Node", Name: "node-1", Count: 1},
+		},
+	}
+	builder := NewMessageBuilder(true, loggerx.NewNoop(), nil)
+
+	// when
+	msg := builder.FromDigest(summary, "dev")
+
+	// then
+	require.Len(t, msg.Sections, 2)
+	assert.Equal(t, "3 events between 01 Jul 23 12:00 UTC and 01 Jul 23 13:00 UTC.", msg.Sections[0].Description)
+	assert.Equal(t, api.BulletLists{
+		{
+			Title: "Events by namespace, kind, reason and level",
+			Items: []string{"❗ default/Pod BackOff: 2", "❗ cluster/Node NodeNotReady: 1"},
+		},
+		{
+			Title: "Top 2 offenders",
+			Items: []string{"Pod default/foo: 2", "Node cluster/node-1: 1"},
+		},
+	}, msg.Sections[0].BulletLists)
+
+	require.Len(t, msg.Sections[1].Buttons, 2)
+	assert.Equal(t, api.MessageBotNamePlaceholder+" kubectl describe pod foo -n default", msg.Sections[1].Buttons[0].Command)
+	assert.Equal(t, api.MessageBotNamePlaceholder+" kubectl describe node node-1", msg.Sections[1].Buttons[1].Command)
+}
+
+func TestMessageBuilderFromDigestNonInteractive(t *testing.T) {
+	// given
+	summary := DigestSummary{
+		Total:        1,
+		TopOffenders: []DigestOffender{{Kind: "Pod", Namespace: "default", Name: "foo", Count: 1}},
+	}
+	builder := NewMessageBuilder(false, loggerx.NewNoop(), nil)
+
+	// when
+	msg := builder.FromDigest(summary, "dev")
+
+	// then
+	assert.Equal(t, api.NonInteractiveSingleSection, msg.Type)
+	assert.Len(t, msg.Sections, 1)
+}
@@ -8,6 +8,7 @@ import (
 	coreV1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
@@ -38,6 +39,8 @@ type Event struct {
 	// When using ELS dynamic mapping, we should avoid complex, dynamic objects, which could result into type conflicts.
 	ObjectMeta metaV1.ObjectMeta `json:"-"`
 	Object     interface{}       `json:"-"`
+	// ObjectUID is the UID of the object the event is about. For Kubernetes Events, it's the UID of the involved object.
+	ObjectUID types.UID `json:"-"`
 }
 
 // Action describes an automated action for a given event.
@@ -73,6 +76,7 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 		Kind:       typeMeta.Kind,
 		ObjectMeta: objectMeta,
 		Object:     object,
+		ObjectUID:  objectMeta.UID,
 		Name:       objectMeta.Name,
 		Namespace:  objectMeta.Namespace,
 		Level:      LevelMap[eventType],
@@ -123,6 +127,7 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 		event.APIVersion = eventObj.InvolvedObject.APIVersion
 		event.Name = eventObj.InvolvedObject.Name
 		event.Namespace = eventObj.InvolvedObject.Namespace
+		event.ObjectUID = eventObj.InvolvedObject.UID
 		event.Level = LevelMap[config.EventType(strings.ToLower(eventObj.Type))]
 		event.Count = eventObj.Count
 		event.Action = eventObj.Action
@@ -0,0 +1,151 @@
+package filters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/interpreter"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const (
+	celObjectVariable = "object"
+	celEventVariable  = "event"
+
+	// celCostLimit limits the runtime cost of a single expression evaluation, so expressions iterating over large objects
+	// don't block the event processing. It's the same as the per-expression limit of Kubernetes validation rules.
+	celCostLimit = 1000000
+	// celInterruptCheckFrequency defines the number of comprehension iterations after which the context cancellation is checked.
+	celInterruptCheckFrequency = 100
+)
+
+// ExpressionFilter filters out events for which at least one of the configured CEL expressions doesn't evaluate to true.
+// Expressions have access to the Kubernetes object as `object` and to the event details as `event`, for example:
+//
+//	object.status.containerStatuses.exists(c, c.restartCount > 5)
+//	event.kind == "Deployment" && object.status.readyReplicas < object.spec.replicas
+type ExpressionFilter struct {
+	log      logrus.FieldLogger
+	programs []compiledExpression
+}
+
+type compiledExpression struct {
+	expression string
+	program    cel.Program
+}
+
+// NewExpressionFilter compiles given expressions and creates a new ExpressionFilter instance.
+func NewExpressionFilter(log logrus.FieldLogger, expressions []string) (*ExpressionFilter, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(celObjectVariable, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(celEventVariable, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("while creating CEL environment: %w", err)
+	}
+
+	var programs []compiledExpression
+	for _, expr := range expressions {
+		ast, issues := env.Compile(expr)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("while compiling expression %q: %w", expr, issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("expression %q must evaluate to bool, got %s", expr, ast.OutputType())
+		}
+
+		prg, err := env.Program(ast, cel.CostLimit(celCostLimit), cel.InterruptCheckFrequency(celInterruptCheckFrequency))
+		if err != nil {
+			return nil, fmt.Errorf("while creating program for expression %q: %w", expr, err)
+		}
+		programs = append(programs, compiledExpression{expression: expr, program: prg})
+	}
+
+	return &ExpressionFilter{log: log, programs: programs}, nil
+}
+
+// Run filters and modifies event struct.
+func (f *ExpressionFilter) Run(ctx context.Context, event *event.Event) error {
+	if len(f.programs) == 0 {
+		return nil
+	}
+
+	obj, err := unstructuredContent(event.Object)
+	if err != nil {
+		return fmt.Errorf("while converting object: %w", err)
+	}
+	vars := map[string]interface{}{
+		celObjectVariable: obj,
+		celEventVariable:  eventVariables(event),
+	}
+
+	for _, expr := range f.programs {
+		out, _, err := expr.program.ContextEval(ctx, vars)
+		var cancelledErr interpreter.EvalCancelledError
+		switch {
+		case errors.As(err, &cancelledErr):
+			f.log.Warnf("Skipping event as evaluation of expression %q was cancelled: %s", expr.expression, err)
+			event.Skip = true
+			return nil
+		case err != nil:
+			// e.g. missing field on a different kind, treat as not matched
+			f.log.Debugf("Skipping event as expression %q cannot be evaluated: %s", expr.expression, err)
+			event.Skip = true
+			return nil
+		}
+
+		matched, ok := out.Value().(bool)
+		if !ok || !matched {
+			f.log.Debugf("Skipping event as expression %q evaluated to %v", expr.expression, out.Value())
+			event.Skip = true
+			return nil
+		}
+	}
+
+	f.log.Debug("Expression filter successful!")
+	return nil
+}
+
+// Name returns the filter's name.
+func (f *ExpressionFilter) Name() string {
+	return "ExpressionFilter"
+}
+
+// Describe describes the filter.
+func (f *ExpressionFilter) Describe() string {
+	return "Filters events based on CEL expressions evaluated against the Kubernetes object and event details."
+}
+
+func unstructuredContent(obj interface{}) (map[string]interface{}, error) {
+	switch o := obj.(type) {
+	case nil:
+		return map[string]interface{}{}, nil
+	case *unstructured.Unstructured:
+		return o.Object, nil
+	default:
+		return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	}
+}
+
+func eventVariables(e *event.Event) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": e.APIVersion,
+		"kind":       e.Kind,
+		"name":       e.Name,
+		"namespace":  e.Namespace,
+		"type":       string(e.Type),
+		"reason":     e.Reason,
+		"level":      string(e.Level),
+		"messages":   e.Messages,
+		"count":      int64(e.Count),
+		"action":     e.Action,
+		"resource":   e.Resource,
+		"cluster":    e.Cluster,
+	}
+}
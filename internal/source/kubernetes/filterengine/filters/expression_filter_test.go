@@ -0,0 +1,176 @@
+package filters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+func TestExpressionFilterRun(t *testing.T) {
+	pod := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "docker.io/library/nginx:1.25"},
+			},
+		},
+		"status": map[string]interface{}{
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"name": "app", "restartCount": int64(7)},
+			},
+		},
+	}}
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"spec":       map[string]interface{}{"replicas": int64(3)},
+		"status":     map[string]interface{}{"readyReplicas": int64(1)},
+	}}
+
+	tests := []struct {
+		name        string
+		expressions []string
+		event       event.Event
+		expSkip     bool
+	}{
+		{
+			name:        "Pod restart count above threshold",
+			expressions: []string{`object.status.containerStatuses.exists(c, c.restartCount > 5)`},
+			event:       event.Event{Kind: "Pod", Object: pod},
+			expSkip:     false,
+		},
+		{
+			name:        "Pod restart count below threshold",
+			expressions: []string{`object.status.containerStatuses.exists(c, c.restartCount > 10)`},
+			event:       event.Event{Kind: "Pod", Object: pod},
+			expSkip:     true,
+		},
+		{
+			name:        "Image not from internal registry",
+			expressions: []string{`object.spec.containers.exists(c, !c.image.startsWith("registry.example.com/"))`},
+			event:       event.Event{Kind: "Pod", Object: pod},
+			expSkip:     false,
+		},
+		{
+			name: "Deployment not fully available",
+			expressions: []string{
+				`event.kind == "Deployment"`,
+				`object.status.readyReplicas < object.spec.replicas`,
+			},
+			event:   event.Event{Kind: "Deployment", Object: deployment},
+			expSkip: false,
+		},
+		{
+			name:        "Event fields",
+			expressions: []string{`event.level == "error" && event.reason == "BackOff" && event.namespace.startsWith("team-")`},
+			event:       event.Event{Kind: "Pod", Namespace: "team-a", Reason: "BackOff", Level: config.Error, Object: pod},
+			expSkip:     false,
+		},
+		{
+			name:        "Missing field is not matched",
+			expressions: []string{`object.status.containerStatuses.exists(c, c.restartCount > 5)`},
+			event:       event.Event{Kind: "Deployment", Object: deployment},
+			expSkip:     true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			f, err := NewExpressionFilter(loggerx.NewNoop(), tc.expressions)
+			require.NoError(t, err)
+
+			// when
+			err = f.Run(context.Background(), &tc.event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expSkip, tc.event.Skip)
+		})
+	}
+}
+
+func TestExpressionFilterSkipsEventsOverCostLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   int
+		expSkip bool
+	}{
+		{
+			name:    "Within cost limit",
+			items:   10,
+			expSkip: false,
+		},
+		{
+			name:    "Over cost limit",
+			items:   2000,
+			expSkip: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var items []interface{}
+			for i := 0; i < tc.items; i++ {
+				items = append(items, int64(i))
+			}
+			cm := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"data":       map[string]interface{}{"items": items},
+			}}
+			ev := event.Event{Kind: "ConfigMap", Object: cm}
+
+			f, err := NewExpressionFilter(loggerx.NewNoop(), []string{`object.data.items.all(a, object.data.items.all(b, a >= 0 && b >= 0))`})
+			require.NoError(t, err)
+
+			// when
+			err = f.Run(context.Background(), &ev)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expSkip, ev.Skip)
+		})
+	}
+}
+
+func TestNewExpressionFilterValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expErrMsg  string
+	}{
+		{
+			name:       "Syntax error",
+			expression: `object.spec.replicas >`,
+			expErrMsg:  `while compiling expression "object.spec.replicas >"`,
+		},
+		{
+			name:       "Unknown variable",
+			expression: `pod.spec.replicas > 1`,
+			expErrMsg:  `while compiling expression "pod.spec.replicas > 1"`,
+		},
+		{
+			name:       "Non-boolean output",
+			expression: `event.name + "-suffix"`,
+			expErrMsg:  `expression "event.name + \"-suffix\"" must evaluate to bool, got string`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := NewExpressionFilter(loggerx.NewNoop(), []string{tc.expression})
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expErrMsg)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package filterengine
 
 import (
+	"fmt"
+
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/dynamic"
@@ -15,7 +17,13 @@ const (
 )
 
 // WithAllFilters returns new DefaultFilterEngine instance with all filters registered.
-func WithAllFilters(logger logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, cfg *config.Filters) *DefaultFilterEngine {
+// It returns an error if any of the configured filter expressions cannot be compiled.
+func WithAllFilters(logger logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, cfg *config.Filters) (*DefaultFilterEngine, error) {
+	expressionFilter, err := filters.NewExpressionFilter(logger.WithField(filterLogFieldKey, "Expression Filter"), cfg.Expressions)
+	if err != nil {
+		return nil, fmt.Errorf("while creating expression filter: %w", err)
+	}
+
 	filterEngine := New(logger.WithField(componentLogFieldKey, "Filter Engine"))
 	filterEngine.Register([]RegisteredFilter{
 		{
@@ -26,7 +34,11 @@ func WithAllFilters(logger logrus.FieldLogger, dynamicCli dynamic.Interface, map
 			Filter:  filters.NewNodeEventsChecker(logger.WithField(filterLogFieldKey, "Node Events Checker")),
 			Enabled: cfg.NodeEventsChecker,
 		},
+		{
+			Filter:  expressionFilter,
+			Enabled: len(cfg.Expressions) > 0,
+		},
 	}...)
 
-	return filterEngine
+	return filterEngine, nil
 }
@@ -3,7 +3,9 @@ package kubernetes
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
+	"time"
 
 	sprig "github.com/go-task/slim-sprig"
 	"github.com/sirupsen/logrus"
@@ -21,6 +23,9 @@ var emojiForLevel = map[config.Level]string{
 	config.Error:   "❗",
 }
 
+// maxDigestGroups limits the number of groups listed in the digest message.
+const maxDigestGroups = 20
+
 type EventCommandsGetter interface {
 	GetCommandsForEvent(event event.Event) ([]commander.Command, error)
 }
@@ -67,9 +72,112 @@ func (m *MessageBuilder) FromEvent(event event.Event, actions []config.ExtraButt
 		})
 	}
 
+	msg.Sections = append(msg.Sections, api.NotificationActionsSection(NotificationKey(event)))
+
 	return msg, nil
 }
 
+// WithTimeline returns a copy of a given message with the object status timeline appended to the first section.
+func (m *MessageBuilder) WithTimeline(msg api.Message, entries []TimelineEntry) api.Message {
+	if len(msg.Sections) == 0 || len(entries) == 0 {
+		return msg
+	}
+
+	var items []string
+	for _, entry := range entries {
+		item := fmt.Sprintf("%s %s %s", emojiForLevel[entry.Level], entry.TimeStamp.UTC().Format("15:04:05"), entry.Type)
+		if entry.Reason != "" {
+			item = fmt.Sprintf("%s (%s)", item, entry.Reason)
+		}
+		if entry.Message != "" {
+			item = fmt.Sprintf("%s: %s", item, entry.Message)
+		}
+		items = append(items, item)
+	}
+
+	sections := append([]api.Section{}, msg.Sections...)
+	sections[0].BulletLists = m.appendBulletListIfNotEmpty(append(api.BulletLists{}, sections[0].BulletLists...), "Status timeline", items)
+	msg.Sections = sections
+	return msg
+}
+
+// NotificationKey returns the key used to acknowledge, snooze and silence notifications for the event object.
+func NotificationKey(e event.Event) string {
+	parts := []string{PluginName, e.Kind}
+	if e.Namespace != "" {
+		parts = append(parts, e.Namespace)
+	}
+	parts = append(parts, e.Name)
+	return strings.Join(parts, "/")
+}
+
+// LivingMessageKey returns the key of a message which is edited in place for the event object.
+// It includes the object UID, so a re-created object gets a new message.
+func LivingMessageKey(e event.Event) string {
+	if e.ObjectUID == "" {
+		return NotificationKey(e)
+	}
+	return fmt.Sprintf("%s/%s", NotificationKey(e), e.ObjectUID)
+}
+
+// FromDigest returns a single message which summarizes events accumulated in a given digest window.
+func (m *MessageBuilder) FromDigest(summary DigestSummary, clusterName string) api.Message {
+	section := api.Section{
+		Base: api.Base{
+			Header:      "📊 Kubernetes events digest",
+			Description: fmt.Sprintf("%d events between %s and %s.", summary.Total, summary.Since.UTC().Format(time.RFC822), summary.Until.UTC().Format(time.RFC822)),
+		},
+	}
+	section.TextFields = m.appendTextFieldIfNotEmpty(section.TextFields, "Cluster", clusterName)
+
+	var groups []string
+	for idx, group := range summary.Groups {
+		if idx == maxDigestGroups {
+			groups = append(groups, fmt.Sprintf("...and %d more", len(summary.Groups)-maxDigestGroups))
+			break
+		}
+		groups = append(groups, fmt.Sprintf("%s %s/%s %s: %d", emojiForLevel[group.Level], namespaceOrCluster(group.Namespace), group.Kind, group.Reason, group.Count))
+	}
+	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Events by namespace, kind, reason and level", groups)
+
+	var offenders []string
+	for _, offender := range summary.TopOffenders {
+		offenders = append(offenders, fmt.Sprintf("%s %s/%s: %d", offender.Kind, namespaceOrCluster(offender.Namespace), offender.Name, offender.Count))
+	}
+	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, fmt.Sprintf("Top %d offenders", len(offenders)), offenders)
+
+	msg := api.Message{
+		Timestamp: summary.Until,
+		Sections:  []api.Section{section},
+	}
+	if !m.isInteractivitySupported {
+		msg.Type = api.NonInteractiveSingleSection
+		return msg
+	}
+
+	btnBuilder := api.NewMessageButtonBuilder()
+	var btns api.Buttons
+	for _, offender := range summary.TopOffenders {
+		cmd := fmt.Sprintf("kubectl describe %s %s", strings.ToLower(offender.Kind), offender.Name)
+		if offender.Namespace != "" {
+			cmd = fmt.Sprintf("%s -n %s", cmd, offender.Namespace)
+		}
+		btns = append(btns, btnBuilder.ForCommandWithoutDesc(fmt.Sprintf("Describe %s", offender.Name), cmd))
+	}
+	if len(btns) > 0 {
+		msg.Sections = append(msg.Sections, api.Section{Buttons: btns})
+	}
+
+	return msg
+}
+
+func namespaceOrCluster(namespace string) string {
+	if namespace == "" {
+		return "cluster"
+	}
+	return namespace
+}
+
 func (m *MessageBuilder) getExternalActions(actions []config.ExtraButtons, e event.Event) (api.Buttons, error) {
 	var actBtns api.Buttons
 	for _, act := range actions {
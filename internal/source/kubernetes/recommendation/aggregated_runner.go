@@ -7,6 +7,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
 	"github.com/kubeshop/botkube/pkg/multierror"
 )
@@ -42,6 +43,9 @@ func (s AggregatedRunner) Do(ctx context.Context, event *event.Event) error {
 
 		event.Recommendations = append(event.Recommendations, result.Info...)
 		event.Warnings = append(event.Warnings, result.Warnings...)
+		if result.Level == config.Error {
+			event.Level = config.Error
+		}
 	}
 
 	return errs.ErrorOrNil()
@@ -0,0 +1,112 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const celObjectVariable = "object"
+
+// Custom is a user-defined recommendation based on a CEL expression evaluated against the Kubernetes object.
+type Custom struct {
+	cfg     config.CustomRecommendation
+	program cel.Program
+}
+
+// NewCustom compiles the expression and creates a new Custom instance.
+func NewCustom(cfg config.CustomRecommendation) (*Custom, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+	if cfg.Resource == "" {
+		return nil, fmt.Errorf("resource for custom recommendation %q cannot be empty", cfg.Name)
+	}
+	if cfg.Message == "" {
+		return nil, fmt.Errorf("message for custom recommendation %q cannot be empty", cfg.Name)
+	}
+	switch cfg.Severity {
+	case "", config.Info, config.Error:
+	default:
+		return nil, fmt.Errorf("unsupported severity %q for custom recommendation %q, allowed values: %q, %q", cfg.Severity, cfg.Name, config.Info, config.Error)
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable(celObjectVariable, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("while creating CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(cfg.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("while compiling expression for custom recommendation %q: %w", cfg.Name, issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression for custom recommendation %q must evaluate to bool, got %s", cfg.Name, ast.OutputType())
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("while creating program for custom recommendation %q: %w", cfg.Name, err)
+	}
+
+	return &Custom{cfg: cfg, program: prg}, nil
+}
+
+// ValidateCustom returns an error if any of the custom recommendations is invalid.
+func ValidateCustom(cfgs []config.CustomRecommendation) error {
+	for _, cfg := range cfgs {
+		if _, err := NewCustom(cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Do executes the recommendation checks.
+func (f *Custom) Do(_ context.Context, event event.Event) (Result, error) {
+	if event.Resource != f.cfg.Resource || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	out, _, err := f.program.Eval(map[string]interface{}{
+		celObjectVariable: unstrObj.Object,
+	})
+	if err != nil {
+		// e.g. missing optional field, treat as not matched
+		return Result{}, nil
+	}
+
+	matched, ok := out.Value().(bool)
+	if !ok || !matched {
+		return Result{}, nil
+	}
+
+	if f.cfg.Severity == config.Error {
+		return Result{
+			Warnings: []string{f.cfg.Message},
+			Level:    config.Error,
+		}, nil
+	}
+
+	return Result{
+		Info: []string{f.cfg.Message},
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *Custom) Name() string {
+	return f.cfg.Name
+}
@@ -0,0 +1,179 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestCustom_Do(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      config.CustomRecommendation
+		expected recommendation.Result
+	}{
+		{
+			name: "Info severity",
+			cfg: config.CustomRecommendation{
+				Name:       "SingleReplica",
+				Resource:   recommendation.DeploymentResourceType(),
+				Expression: "object.spec.replicas < 2",
+				Message:    "Deployment runs less than two replicas.",
+			},
+			expected: recommendation.Result{
+				Info: []string{"Deployment runs less than two replicas."},
+			},
+		},
+		{
+			name: "Error severity",
+			cfg: config.CustomRecommendation{
+				Name:       "SingleReplica",
+				Resource:   recommendation.DeploymentResourceType(),
+				Expression: "object.spec.replicas < 2",
+				Message:    "Deployment runs less than two replicas.",
+				Severity:   config.Error,
+			},
+			expected: recommendation.Result{
+				Warnings: []string{"Deployment runs less than two replicas."},
+				Level:    config.Error,
+			},
+		},
+		{
+			name: "Not matched",
+			cfg: config.CustomRecommendation{
+				Name:       "ManyReplicas",
+				Resource:   recommendation.DeploymentResourceType(),
+				Expression: "object.spec.replicas > 5",
+				Message:    "Deployment runs more than five replicas.",
+			},
+			expected: recommendation.Result{},
+		},
+		{
+			name: "Different resource",
+			cfg: config.CustomRecommendation{
+				Name:       "SingleReplica",
+				Resource:   "apps/v1/statefulsets",
+				Expression: "object.spec.replicas < 2",
+				Message:    "StatefulSet runs less than two replicas.",
+			},
+			expected: recommendation.Result{},
+		},
+		{
+			name: "Missing field",
+			cfg: config.CustomRecommendation{
+				Name:       "NoStrategy",
+				Resource:   recommendation.DeploymentResourceType(),
+				Expression: `object.spec.strategy.type == "Recreate"`,
+				Message:    "Deployment uses Recreate strategy.",
+			},
+			expected: recommendation.Result{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			recomm, err := recommendation.NewCustom(tc.cfg)
+			require.NoError(t, err)
+
+			deployment := fixDeployment(ptr.FromType[int32](1))
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := event.New(deployment.ObjectMeta, unstr, config.CreateEvent, recommendation.DeploymentResourceType())
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestValidateCustom(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       config.CustomRecommendation
+		expErrMsg string
+	}{
+		{
+			name:      "Missing resource",
+			cfg:       config.CustomRecommendation{Name: "foo", Expression: "true", Message: "foo"},
+			expErrMsg: `resource for custom recommendation "foo" cannot be empty`,
+		},
+		{
+			name:      "Invalid severity",
+			cfg:       config.CustomRecommendation{Name: "foo", Resource: "v1/pods", Expression: "true", Message: "foo", Severity: config.Success},
+			expErrMsg: `unsupported severity "success" for custom recommendation "foo", allowed values: "info", "error"`,
+		},
+		{
+			name:      "Invalid expression",
+			cfg:       config.CustomRecommendation{Name: "foo", Resource: "v1/pods", Expression: "object.spec >", Message: "foo"},
+			expErrMsg: `while compiling expression for custom recommendation "foo"`,
+		},
+		{
+			name:      "Non-boolean output",
+			cfg:       config.CustomRecommendation{Name: "foo", Resource: "v1/pods", Expression: `"foo"`, Message: "foo"},
+			expErrMsg: `expression for custom recommendation "foo" must evaluate to bool, got string`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			err := recommendation.ValidateCustom([]config.CustomRecommendation{tc.cfg})
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expErrMsg)
+		})
+	}
+}
+
+func TestAggregatedRunner_CustomErrorSeverityRaisesEventLevel(t *testing.T) {
+	// given
+	cfg := config.Config{
+		Recommendations: &config.Recommendations{
+			Custom: []config.CustomRecommendation{
+				{
+					Name:       "SingleReplica",
+					Resource:   recommendation.DeploymentResourceType(),
+					Expression: "object.spec.replicas < 2",
+					Message:    "Deployment runs less than two replicas.",
+					Severity:   config.Error,
+				},
+			},
+		},
+	}
+	factory := recommendation.NewFactory(loggerx.NewNoop(), nil)
+	recRunner, _ := factory.New(cfg)
+
+	deployment := fixDeployment(ptr.FromType[int32](1))
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deployment)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(deployment.ObjectMeta, unstr, config.CreateEvent, recommendation.DeploymentResourceType())
+	require.NoError(t, err)
+	event.Level = config.Info
+
+	// when
+	err = recRunner.Do(context.Background(), &event)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, config.Error, event.Level)
+	assert.Equal(t, []string{"Deployment runs less than two replicas."}, event.Warnings)
+}
@@ -0,0 +1,91 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const deploymentPodDisruptionBudgetSetName = "DeploymentPodDisruptionBudgetSet"
+
+// DeploymentPodDisruptionBudgetSet adds recommendations if a single-replica Deployment is not covered by any PodDisruptionBudget.
+type DeploymentPodDisruptionBudgetSet struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewDeploymentPodDisruptionBudgetSet creates a new DeploymentPodDisruptionBudgetSet instance.
+func NewDeploymentPodDisruptionBudgetSet(dynamicCli dynamic.Interface) *DeploymentPodDisruptionBudgetSet {
+	return &DeploymentPodDisruptionBudgetSet{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *DeploymentPodDisruptionBudgetSet) Do(ctx context.Context, event event.Event) (Result, error) {
+	var deployment appsv1.Deployment
+	ok, err := typedObjectForCreateEvent(event, "Deployment", &deployment)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas > 1 {
+		return Result{}, nil
+	}
+
+	covered, err := f.isCoveredByPDB(ctx, deployment)
+	if err != nil {
+		return Result{}, err
+	}
+	if covered {
+		return Result{}, nil
+	}
+
+	return Result{
+		Info: []string{
+			fmt.Sprintf("Deployment '%s/%s' runs a single replica and is not covered by any PodDisruptionBudget. Consider running more replicas and defining a PodDisruptionBudget.", deployment.Namespace, deployment.Name),
+		},
+	}, nil
+}
+
+func (f *DeploymentPodDisruptionBudgetSet) isCoveredByPDB(ctx context.Context, deployment appsv1.Deployment) (bool, error) {
+	pdbGVR := schema.GroupVersionResource{
+		Group:    "policy",
+		Version:  "v1",
+		Resource: "poddisruptionbudgets",
+	}
+	list, err := f.dynamicCli.Resource(pdbGVR).Namespace(deployment.Namespace).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("while listing PodDisruptionBudgets: %w", err)
+	}
+
+	podLabels := labels.Set(deployment.Spec.Template.Labels)
+	for i := range list.Items {
+		var pdb policyv1.PodDisruptionBudget
+		if err := k8sutil.TransformIntoTypedObject(&list.Items[i], &pdb); err != nil {
+			return false, fmt.Errorf("while transforming PodDisruptionBudget: %w", err)
+		}
+		if pdb.Spec.Selector == nil {
+			continue
+		}
+		selector, err := metaV1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if !selector.Empty() && selector.Matches(podLabels) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Name returns the recommendation name.
+func (f *DeploymentPodDisruptionBudgetSet) Name() string {
+	return deploymentPodDisruptionBudgetSetName
+}
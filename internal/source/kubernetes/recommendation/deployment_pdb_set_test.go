@@ -0,0 +1,109 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestDeploymentPodDisruptionBudgetSet_Do(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		objects    []runtime.Object
+		expected   recommendation.Result
+	}{
+		{
+			name:       "Single replica without PodDisruptionBudget",
+			deployment: fixDeployment(ptr.FromType[int32](1)),
+			objects:    []runtime.Object{fixPodDisruptionBudget("other", "foo")},
+			expected: recommendation.Result{
+				Info: []string{
+					"Deployment 'foo/deploy-name' runs a single replica and is not covered by any PodDisruptionBudget. Consider running more replicas and defining a PodDisruptionBudget.",
+				},
+			},
+		},
+		{
+			name:       "Single replica with PodDisruptionBudget",
+			deployment: fixDeployment(nil),
+			objects:    []runtime.Object{fixPodDisruptionBudget("app", "foo")},
+			expected:   recommendation.Result{},
+		},
+		{
+			name:       "Multiple replicas",
+			deployment: fixDeployment(ptr.FromType[int32](3)),
+			expected:   recommendation.Result{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, tc.objects...)
+			recomm := recommendation.NewDeploymentPodDisruptionBudgetSet(dynamicCli)
+
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.deployment)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := event.New(tc.deployment.ObjectMeta, unstr, config.CreateEvent, recommendation.DeploymentResourceType())
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func fixDeployment(replicas *int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deploy-name",
+			Namespace: "foo",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: replicas,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "foo"},
+				},
+			},
+		},
+	}
+}
+
+func fixPodDisruptionBudget(labelKey, labelValue string) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pdb",
+			Namespace: "foo",
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{labelKey: labelValue},
+			},
+		},
+	}
+}
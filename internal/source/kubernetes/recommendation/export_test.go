@@ -11,3 +11,15 @@ func PodResourceType() string {
 func IngressResourceType() string {
 	return ingressResourceType
 }
+
+func DeploymentResourceType() string {
+	return deploymentsResourceType
+}
+
+func ServiceResourceType() string {
+	return servicesResourceType
+}
+
+func HPAResourceType() string {
+	return hpaResourceType
+}
@@ -2,6 +2,8 @@ package recommendation
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/client-go/dynamic"
@@ -21,17 +23,23 @@ type Recommendation interface {
 type Result struct {
 	Info     []string
 	Warnings []string
+
+	// Level overrides the event level if set to config.Error.
+	Level config.Level
 }
 
 // Factory is a factory for creating recommendation sets.
 type Factory struct {
 	logger     logrus.FieldLogger
 	dynamicCli dynamic.Interface
+
+	mu     sync.Mutex
+	custom map[string]*Custom
 }
 
 // NewFactory creates a new Factory instance.
 func NewFactory(logger logrus.FieldLogger, dynamicCli dynamic.Interface) *Factory {
-	return &Factory{logger: logger, dynamicCli: dynamicCli}
+	return &Factory{logger: logger, dynamicCli: dynamicCli, custom: map[string]*Custom{}}
 }
 
 // New creates a new AggregatedRunner.
@@ -58,5 +66,61 @@ func (f *Factory) recommendationsForConfig(cfg config.Recommendations) []Recomme
 		recommendations = append(recommendations, NewIngressTLSSecretValid(f.dynamicCli))
 	}
 
+	if ptr.ToValue(cfg.Pod.ResourcesSet) {
+		recommendations = append(recommendations, NewPodResourcesSet())
+	}
+
+	if ptr.ToValue(cfg.Pod.ProbesSet) {
+		recommendations = append(recommendations, NewPodProbesSet())
+	}
+
+	if ptr.ToValue(cfg.Pod.NoPrivilegedContainers) {
+		recommendations = append(recommendations, NewPodNoPrivilegedContainers())
+	}
+
+	if ptr.ToValue(cfg.Pod.NoHostPathVolumes) {
+		recommendations = append(recommendations, NewPodNoHostPathVolumes())
+	}
+
+	if ptr.ToValue(cfg.Deployment.PodDisruptionBudgetSet) {
+		recommendations = append(recommendations, NewDeploymentPodDisruptionBudgetSet(f.dynamicCli))
+	}
+
+	if ptr.ToValue(cfg.Service.EndpointsExist) {
+		recommendations = append(recommendations, NewServiceEndpointsExist(f.dynamicCli, dependentObjectsGracePeriod))
+	}
+
+	if ptr.ToValue(cfg.HPA.ScaleTargetExists) {
+		recommendations = append(recommendations, NewHPAScaleTargetExists(f.dynamicCli, dependentObjectsGracePeriod))
+	}
+
+	for _, customCfg := range cfg.Custom {
+		custom, err := f.customRecommendation(customCfg)
+		if err != nil {
+			f.logger.Errorf("Skipping invalid custom recommendation: %s", err)
+			continue
+		}
+		recommendations = append(recommendations, custom)
+	}
+
 	return recommendations
 }
+
+// customRecommendation returns a cached custom recommendation, as compiling CEL expressions for each event is expensive.
+func (f *Factory) customRecommendation(cfg config.CustomRecommendation) (*Custom, error) {
+	key := fmt.Sprintf("%s/%s/%s/%s/%s", cfg.Name, cfg.Resource, cfg.Severity, cfg.Expression, cfg.Message)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if custom, ok := f.custom[key]; ok {
+		return custom, nil
+	}
+
+	custom, err := NewCustom(cfg)
+	if err != nil {
+		return nil, err
+	}
+	f.custom[key] = custom
+	return custom, nil
+}
@@ -25,11 +25,20 @@ func TestFactory_New(t *testing.T) {
 				BackendServiceValid: ptr.FromType(true),
 				// keep TLSSecretValid not specified
 			},
+			Service: config.ServiceRecommendations{
+				EndpointsExist: ptr.FromType(true),
+			},
+			Custom: []config.CustomRecommendation{
+				{Name: "SingleReplica", Resource: "apps/v1/deployments", Expression: "object.spec.replicas < 2", Message: "foo"},
+				{Name: "Invalid", Resource: "apps/v1/deployments", Expression: "object.spec.replicas <", Message: "foo"},
+			},
 		},
 	}
 	expectedNames := []string{
 		"PodLabelsSet",
 		"IngressBackendServiceValid",
+		"ServiceEndpointsExist",
+		"SingleReplica",
 	}
 	expectedRecCfg := config.Recommendations{
 		Pod: config.PodRecommendations{
@@ -40,6 +49,10 @@ func TestFactory_New(t *testing.T) {
 			BackendServiceValid: ptr.FromType(true),
 			TLSSecretValid:      nil,
 		},
+		Service: config.ServiceRecommendations{
+			EndpointsExist: ptr.FromType(true),
+		},
+		Custom: cfg.Recommendations.Custom,
 	}
 
 	factory := recommendation.NewFactory(loggerx.NewNoop(), nil)
@@ -0,0 +1,83 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const hpaScaleTargetExistsName = "HPAScaleTargetExists"
+
+// scaleTargetResources maps supported scale target kinds to their resources.
+var scaleTargetResources = map[string]string{
+	"Deployment":            "deployments",
+	"StatefulSet":           "statefulsets",
+	"ReplicaSet":            "replicasets",
+	"ReplicationController": "replicationcontrollers",
+}
+
+// HPAScaleTargetExists adds warnings if the HorizontalPodAutoscaler targets a resource that doesn't exist.
+// As HorizontalPodAutoscalers might be applied before their scale targets, the target is awaited for a given grace period.
+type HPAScaleTargetExists struct {
+	dynamicCli  dynamic.Interface
+	gracePeriod time.Duration
+}
+
+// NewHPAScaleTargetExists creates a new HPAScaleTargetExists instance.
+func NewHPAScaleTargetExists(dynamicCli dynamic.Interface, gracePeriod time.Duration) *HPAScaleTargetExists {
+	return &HPAScaleTargetExists{dynamicCli: dynamicCli, gracePeriod: gracePeriod}
+}
+
+// Do executes the recommendation checks.
+func (f *HPAScaleTargetExists) Do(ctx context.Context, event event.Event) (Result, error) {
+	var hpa autoscalingv2.HorizontalPodAutoscaler
+	ok, err := typedObjectForCreateEvent(event, "HorizontalPodAutoscaler", &hpa)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	target := hpa.Spec.ScaleTargetRef
+	resource, found := scaleTargetResources[target.Kind]
+	if !found {
+		// custom resources are not supported
+		return Result{}, nil
+	}
+	gv, err := schema.ParseGroupVersion(target.APIVersion)
+	if err != nil {
+		return Result{}, fmt.Errorf("while parsing scale target API version: %w", err)
+	}
+
+	targetExists, err := waitForDependentObjects(ctx, f.gracePeriod, func(ctx context.Context) (bool, error) {
+		_, err := f.dynamicCli.Resource(gv.WithResource(resource)).Namespace(hpa.Namespace).Get(ctx, target.Name, metaV1.GetOptions{})
+		switch {
+		case err == nil:
+			return true, nil
+		case apierrors.IsNotFound(err):
+			return false, nil
+		default:
+			return false, fmt.Errorf("while getting scale target: %w", err)
+		}
+	})
+	if err != nil || targetExists {
+		return Result{}, err
+	}
+
+	return Result{
+		Warnings: []string{
+			fmt.Sprintf("%s '%s' referred in HorizontalPodAutoscaler '%s/%s' spec does not exist.", target.Kind, target.Name, hpa.Namespace, hpa.Name),
+		},
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *HPAScaleTargetExists) Name() string {
+	return hpaScaleTargetExistsName
+}
@@ -0,0 +1,74 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestHPAScaleTargetExists_Do(t *testing.T) {
+	tests := []struct {
+		name       string
+		targetName string
+		expected   recommendation.Result
+	}{
+		{
+			name:       "Scale target doesn't exist",
+			targetName: "not-existing",
+			expected: recommendation.Result{
+				Warnings: []string{
+					"Deployment 'not-existing' referred in HorizontalPodAutoscaler 'foo/hpa-name' spec does not exist.",
+				},
+			},
+		},
+		{
+			name:       "Scale target exists",
+			targetName: "deploy-name",
+			expected:   recommendation.Result{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixDeployment(nil))
+			recomm := recommendation.NewHPAScaleTargetExists(dynamicCli, 0)
+
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{
+				TypeMeta:   metav1.TypeMeta{Kind: "HorizontalPodAutoscaler", APIVersion: "autoscaling/v2"},
+				ObjectMeta: metav1.ObjectMeta{Name: "hpa-name", Namespace: "foo"},
+				Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       tc.targetName,
+					},
+				},
+			}
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(hpa)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := event.New(hpa.ObjectMeta, unstr, config.CreateEvent, recommendation.HPAResourceType())
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+// typedObjectForCreateEvent transforms the object of a given create event into a typed object.
+// It returns false if the event doesn't relate to a given kind.
+func typedObjectForCreateEvent(event event.Event, kind string, out interface{}) (bool, error) {
+	if event.Kind != kind || event.Type != config.CreateEvent || k8sutil.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return false, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return false, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	err := k8sutil.TransformIntoTypedObject(unstrObj, out)
+	if err != nil {
+		return false, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, out, err)
+	}
+	return true, nil
+}
+
+const (
+	// dependentObjectsGracePeriod defines how long checks wait for objects which are usually applied together with the checked one,
+	// e.g. Pods of a Deployment applied in the same Helm release as a Service selecting them.
+	dependentObjectsGracePeriod = 30 * time.Second
+
+	dependentObjectsPollInterval = 5 * time.Second
+)
+
+// waitForDependentObjects checks a given condition until it's met or the grace period elapses.
+// It returns false if the condition wasn't met within the grace period.
+func waitForDependentObjects(ctx context.Context, gracePeriod time.Duration, condition wait.ConditionWithContextFunc) (bool, error) {
+	interval := dependentObjectsPollInterval
+	if gracePeriod < interval {
+		interval = gracePeriod
+	}
+	if interval <= 0 {
+		return condition(ctx)
+	}
+
+	err := wait.PollUntilContextTimeout(ctx, interval, gracePeriod, true, condition)
+	switch {
+	case err == nil:
+		return true, nil
+	case wait.Interrupted(err) && ctx.Err() == nil:
+		return false, nil
+	default:
+		return false, err
+	}
+}
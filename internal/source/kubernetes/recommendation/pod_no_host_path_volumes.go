@@ -0,0 +1,44 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const podNoHostPathVolumesName = "PodNoHostPathVolumes"
+
+// PodNoHostPathVolumes adds warnings if Pod mounts hostPath volumes.
+type PodNoHostPathVolumes struct{}
+
+// NewPodNoHostPathVolumes creates a new PodNoHostPathVolumes instance.
+func NewPodNoHostPathVolumes() *PodNoHostPathVolumes {
+	return &PodNoHostPathVolumes{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodNoHostPathVolumes) Do(_ context.Context, event event.Event) (Result, error) {
+	var pod coreV1.Pod
+	ok, err := typedObjectForCreateEvent(event, "Pod", &pod)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	var warningMsgs []string
+	for _, v := range pod.Spec.Volumes {
+		if v.HostPath == nil {
+			continue
+		}
+		warningMsgs = append(warningMsgs, fmt.Sprintf("Pod '%s/%s' mounts hostPath volume '%s' with path '%s'.", pod.Namespace, pod.Name, v.Name, v.HostPath.Path))
+	}
+
+	return Result{Warnings: warningMsgs}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodNoHostPathVolumes) Name() string {
+	return podNoHostPathVolumesName
+}
@@ -0,0 +1,50 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const podNoPrivilegedContainersName = "PodNoPrivilegedContainers"
+
+// PodNoPrivilegedContainers adds warnings if Pod containers run in privileged mode.
+type PodNoPrivilegedContainers struct{}
+
+// NewPodNoPrivilegedContainers creates a new PodNoPrivilegedContainers instance.
+func NewPodNoPrivilegedContainers() *PodNoPrivilegedContainers {
+	return &PodNoPrivilegedContainers{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodNoPrivilegedContainers) Do(_ context.Context, event event.Event) (Result, error) {
+	var pod coreV1.Pod
+	ok, err := typedObjectForCreateEvent(event, "Pod", &pod)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	warningMsgs := f.checkContainers("initContainer", pod.Spec.InitContainers, pod)
+	warningMsgs = append(warningMsgs, f.checkContainers("container", pod.Spec.Containers, pod)...)
+
+	return Result{Warnings: warningMsgs}, nil
+}
+
+func (f *PodNoPrivilegedContainers) checkContainers(fieldName string, containers []coreV1.Container, pod coreV1.Pod) []string {
+	var warnings []string
+	for _, c := range containers {
+		if c.SecurityContext == nil || c.SecurityContext.Privileged == nil || !*c.SecurityContext.Privileged {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("Pod '%s/%s' %s '%s' runs in privileged mode.", pod.Namespace, pod.Name, fieldName, c.Name))
+	}
+	return warnings
+}
+
+// Name returns the recommendation name.
+func (f *PodNoPrivilegedContainers) Name() string {
+	return podNoPrivilegedContainersName
+}
@@ -0,0 +1,58 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodNoPrivilegedContainers_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Warnings: []string{
+			"Pod 'foo/pod-name' initContainer 'privileged-init' runs in privileged mode.",
+			"Pod 'foo/pod-name' container 'privileged' runs in privileged mode.",
+		},
+	}
+
+	recomm := recommendation.NewPodNoPrivilegedContainers()
+
+	pod := &v1.Pod{
+		TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-name", Namespace: "foo"},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{
+				{Name: "privileged-init", SecurityContext: &v1.SecurityContext{Privileged: ptr.FromType(true)}},
+			},
+			Containers: []v1.Container{
+				{Name: "default"},
+				{Name: "unprivileged", SecurityContext: &v1.SecurityContext{Privileged: ptr.FromType(false)}},
+				{Name: "privileged", SecurityContext: &v1.SecurityContext{Privileged: ptr.FromType(true)}},
+			},
+		},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
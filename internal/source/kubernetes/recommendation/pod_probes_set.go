@@ -0,0 +1,52 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const podProbesSetName = "PodProbesSet"
+
+// PodProbesSet adds recommendations if Pod containers don't define liveness or readiness probes.
+type PodProbesSet struct{}
+
+// NewPodProbesSet creates a new PodProbesSet instance.
+func NewPodProbesSet() *PodProbesSet {
+	return &PodProbesSet{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodProbesSet) Do(_ context.Context, event event.Event) (Result, error) {
+	var pod coreV1.Pod
+	ok, err := typedObjectForCreateEvent(event, "Pod", &pod)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	var infoMsgs []string
+	for _, c := range pod.Spec.Containers {
+		var missing []string
+		if c.LivenessProbe == nil {
+			missing = append(missing, "liveness")
+		}
+		if c.ReadinessProbe == nil {
+			missing = append(missing, "readiness")
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		infoMsgs = append(infoMsgs, fmt.Sprintf("Container '%s' of Pod '%s/%s' doesn't define %s probe.", c.Name, pod.Namespace, pod.Name, strings.Join(missing, " and ")))
+	}
+
+	return Result{Info: infoMsgs}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodProbesSet) Name() string {
+	return podProbesSetName
+}
@@ -0,0 +1,55 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodProbesSet_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Info: []string{
+			"Container 'no-probes' of Pod 'foo/pod-name' doesn't define liveness and readiness probe.",
+			"Container 'liveness-only' of Pod 'foo/pod-name' doesn't define readiness probe.",
+		},
+	}
+
+	recomm := recommendation.NewPodProbesSet()
+
+	probe := &v1.Probe{ProbeHandler: v1.ProbeHandler{Exec: &v1.ExecAction{Command: []string{"true"}}}}
+	pod := &v1.Pod{
+		TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-name", Namespace: "foo"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "no-probes"},
+				{Name: "liveness-only", LivenessProbe: probe},
+				{Name: "all", LivenessProbe: probe, ReadinessProbe: probe},
+			},
+		},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,52 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const podResourcesSetName = "PodResourcesSet"
+
+// PodResourcesSet adds recommendations if Pod containers don't specify resource requests or limits.
+type PodResourcesSet struct{}
+
+// NewPodResourcesSet creates a new PodResourcesSet instance.
+func NewPodResourcesSet() *PodResourcesSet {
+	return &PodResourcesSet{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodResourcesSet) Do(_ context.Context, event event.Event) (Result, error) {
+	var pod coreV1.Pod
+	ok, err := typedObjectForCreateEvent(event, "Pod", &pod)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	var infoMsgs []string
+	for _, c := range pod.Spec.Containers {
+		var missing []string
+		if len(c.Resources.Requests) == 0 {
+			missing = append(missing, "requests")
+		}
+		if len(c.Resources.Limits) == 0 {
+			missing = append(missing, "limits")
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		infoMsgs = append(infoMsgs, fmt.Sprintf("Container '%s' of Pod '%s/%s' doesn't specify resource %s.", c.Name, pod.Namespace, pod.Name, strings.Join(missing, " and ")))
+	}
+
+	return Result{Info: infoMsgs}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodResourcesSet) Name() string {
+	return podResourcesSetName
+}
@@ -0,0 +1,56 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestPodResourcesSet_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Info: []string{
+			"Container 'no-resources' of Pod 'foo/pod-name' doesn't specify resource requests and limits.",
+			"Container 'requests-only' of Pod 'foo/pod-name' doesn't specify resource limits.",
+		},
+	}
+
+	recomm := recommendation.NewPodResourcesSet()
+
+	resources := v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")}
+	pod := &v1.Pod{
+		TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-name", Namespace: "foo"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "no-resources"},
+				{Name: "requests-only", Resources: v1.ResourceRequirements{Requests: resources}},
+				{Name: "all", Resources: v1.ResourceRequirements{Requests: resources, Limits: resources}},
+			},
+		},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := event.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
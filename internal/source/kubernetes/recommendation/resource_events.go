@@ -7,8 +7,11 @@ import (
 )
 
 const (
-	podsResourceType    = "v1/pods"
-	ingressResourceType = "networking.k8s.io/v1/ingresses"
+	podsResourceType        = "v1/pods"
+	ingressResourceType     = "networking.k8s.io/v1/ingresses"
+	deploymentsResourceType = "apps/v1/deployments"
+	servicesResourceType    = "v1/services"
+	hpaResourceType         = "autoscaling/v2/horizontalpodautoscalers"
 )
 
 // ResourceEventsForConfig returns the resource event map for a given source recommendations config.
@@ -22,10 +25,31 @@ func ResourceEventsForConfig(recCfg *config.Recommendations) map[string]config.E
 		resTypes[ingressResourceType] = config.CreateEvent
 	}
 
-	if ptr.ToValue(recCfg.Pod.NoLatestImageTag) || ptr.ToValue(recCfg.Pod.LabelsSet) {
+	pod := recCfg.Pod
+	if ptr.ToValue(pod.NoLatestImageTag) || ptr.ToValue(pod.LabelsSet) || ptr.ToValue(pod.ResourcesSet) ||
+		ptr.ToValue(pod.ProbesSet) || ptr.ToValue(pod.NoPrivilegedContainers) || ptr.ToValue(pod.NoHostPathVolumes) {
 		resTypes[podsResourceType] = config.CreateEvent
 	}
 
+	if ptr.ToValue(recCfg.Deployment.PodDisruptionBudgetSet) {
+		resTypes[deploymentsResourceType] = config.CreateEvent
+	}
+
+	if ptr.ToValue(recCfg.Service.EndpointsExist) {
+		resTypes[servicesResourceType] = config.CreateEvent
+	}
+
+	if ptr.ToValue(recCfg.HPA.ScaleTargetExists) {
+		resTypes[hpaResourceType] = config.CreateEvent
+	}
+
+	for _, custom := range recCfg.Custom {
+		if custom.Resource == "" {
+			continue
+		}
+		resTypes[custom.Resource] = config.CreateEvent
+	}
+
 	return resTypes
 }
 
@@ -62,6 +62,61 @@ func TestResourceEventsForConfig(t *testing.T) {
 				recommendation.IngressResourceType(): config.CreateEvent,
 			},
 		},
+		{
+			Name: "Pod Resources Set",
+			RecCfg: config.Recommendations{
+				Pod: config.PodRecommendations{
+					ResourcesSet: ptr.FromType(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.PodResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "Deployment PodDisruptionBudget Set",
+			RecCfg: config.Recommendations{
+				Deployment: config.DeploymentRecommendations{
+					PodDisruptionBudgetSet: ptr.FromType(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.DeploymentResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "Service Endpoints Exist",
+			RecCfg: config.Recommendations{
+				Service: config.ServiceRecommendations{
+					EndpointsExist: ptr.FromType(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.ServiceResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "HPA Scale Target Exists",
+			RecCfg: config.Recommendations{
+				HPA: config.HPARecommendations{
+					ScaleTargetExists: ptr.FromType(true),
+				},
+			},
+			Expected: map[string]config.EventType{
+				recommendation.HPAResourceType(): config.CreateEvent,
+			},
+		},
+		{
+			Name: "Custom",
+			RecCfg: config.Recommendations{
+				Custom: []config.CustomRecommendation{
+					{Name: "foo", Resource: "apps/v1/statefulsets"},
+				},
+			},
+			Expected: map[string]config.EventType{
+				"apps/v1/statefulsets": config.CreateEvent,
+			},
+		},
 		{
 			Name: "All",
 			RecCfg: config.Recommendations{
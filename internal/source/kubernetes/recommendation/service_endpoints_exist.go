@@ -0,0 +1,72 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const serviceEndpointsExistName = "ServiceEndpointsExist"
+
+// ServiceEndpointsExist adds warnings if the Service selector doesn't match any Pod.
+// As Services are usually applied before Pods of their workloads exist, Pods are awaited for a given grace period.
+type ServiceEndpointsExist struct {
+	dynamicCli  dynamic.Interface
+	gracePeriod time.Duration
+}
+
+// NewServiceEndpointsExist creates a new ServiceEndpointsExist instance.
+func NewServiceEndpointsExist(dynamicCli dynamic.Interface, gracePeriod time.Duration) *ServiceEndpointsExist {
+	return &ServiceEndpointsExist{dynamicCli: dynamicCli, gracePeriod: gracePeriod}
+}
+
+// Do executes the recommendation checks.
+func (f *ServiceEndpointsExist) Do(ctx context.Context, event event.Event) (Result, error) {
+	var svc coreV1.Service
+	ok, err := typedObjectForCreateEvent(event, "Service", &svc)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	// Services without selector have manually managed endpoints
+	if len(svc.Spec.Selector) == 0 || svc.Spec.Type == coreV1.ServiceTypeExternalName {
+		return Result{}, nil
+	}
+
+	podGVR := schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "pods",
+	}
+	podsExist, err := waitForDependentObjects(ctx, f.gracePeriod, func(ctx context.Context) (bool, error) {
+		pods, err := f.dynamicCli.Resource(podGVR).Namespace(svc.Namespace).List(ctx, metaV1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+			Limit:         1,
+		})
+		if err != nil {
+			return false, fmt.Errorf("while listing Pods: %w", err)
+		}
+		return len(pods.Items) > 0, nil
+	})
+	if err != nil || podsExist {
+		return Result{}, err
+	}
+
+	return Result{
+		Warnings: []string{
+			fmt.Sprintf("Service '%s/%s' has no endpoints as its selector doesn't match any Pod.", svc.Namespace, svc.Name),
+		},
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *ServiceEndpointsExist) Name() string {
+	return serviceEndpointsExistName
+}
@@ -0,0 +1,110 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+)
+
+func TestServiceEndpointsExist_Do(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector map[string]string
+		expected recommendation.Result
+	}{
+		{
+			name:     "Selector doesn't match any Pod",
+			selector: map[string]string{"app": "not-existing"},
+			expected: recommendation.Result{
+				Warnings: []string{
+					"Service 'foo/svc-name' has no endpoints as its selector doesn't match any Pod.",
+				},
+			},
+		},
+		{
+			name:     "Selector matches Pod",
+			selector: map[string]string{"app": "foo"},
+			expected: recommendation.Result{},
+		},
+		{
+			name:     "No selector",
+			selector: nil,
+			expected: recommendation.Result{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			pod := fixPod()
+			pod.Labels = map[string]string{"app": "foo"}
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, pod)
+			recomm := recommendation.NewServiceEndpointsExist(dynamicCli, 0)
+
+			svc := &v1.Service{
+				TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "svc-name", Namespace: "foo"},
+				Spec:       v1.ServiceSpec{Selector: tc.selector},
+			}
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(svc)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := event.New(svc.ObjectMeta, unstr, config.CreateEvent, recommendation.ServiceResourceType())
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestServiceEndpointsExist_DoWaitsForPods(t *testing.T) {
+	// given
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme)
+	recomm := recommendation.NewServiceEndpointsExist(dynamicCli, 2*time.Second)
+
+	svc := &v1.Service{
+		TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "svc-name", Namespace: "foo"},
+		Spec:       v1.ServiceSpec{Selector: map[string]string{"app": "foo"}},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(svc)
+	require.NoError(t, err)
+
+	event, err := event.New(svc.ObjectMeta, &unstructured.Unstructured{Object: unstrObj}, config.CreateEvent, recommendation.ServiceResourceType())
+	require.NoError(t, err)
+
+	// Pods of a Deployment are usually created after the Service
+	pod := fixPod()
+	pod.Labels = map[string]string{"app": "foo"}
+	podObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_, _ = dynamicCli.Resource(v1.SchemeGroupVersion.WithResource("pods")).Namespace("foo").Create(context.Background(), &unstructured.Unstructured{Object: podObj}, metav1.CreateOptions{})
+	}()
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, recommendation.Result{}, actual)
+}
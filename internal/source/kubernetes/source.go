@@ -16,10 +16,12 @@ import (
 
 	"github.com/kubeshop/botkube/internal/command"
 	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/checkpoint"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/commander"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/filterengine"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/filterengine/filters"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
 	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/source"
@@ -53,6 +55,10 @@ type Source struct {
 	clusterName              string
 	kubeConfig               []byte
 	messageBuilder           *MessageBuilder
+	correlationResolver      *CorrelationKeyResolver
+	checkpointer             *checkpoint.Checkpointer
+	digest                   *Digest
+	timeline                 *Timeline
 	isInteractivitySupported bool
 }
 
@@ -73,6 +79,15 @@ func (*Source) Stream(ctx context.Context, input source.StreamInput) (source.Str
 	if err != nil {
 		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
 	}
+
+	// fail fast on invalid filter expressions
+	if _, err := filters.NewExpressionFilter(loggerx.NewNoop(), cfg.Filters.Expressions); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating filter expressions: %w", err)
+	}
+	if err := recommendation.ValidateCustom(cfg.Recommendations.Custom); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating custom recommendations: %w", err)
+	}
+
 	s := Source{
 		startTime: time.Now(),
 		eventCh:   make(chan source.Event),
@@ -104,6 +119,14 @@ func consumeEvents(ctx context.Context, s Source) {
 	client, err := NewClient(s.kubeConfig)
 	exitOnError(err, s.logger)
 
+	if s.config.Checkpoint.Enabled {
+		store, err := checkpoint.NewStore(s.config.Checkpoint, client.k8sCli)
+		exitOnError(err, s.logger)
+		s.checkpointer, err = checkpoint.New(ctx, s.logger.WithField(componentLogFieldKey, "Checkpointer"), s.config.Checkpoint, store, s.startTime)
+		exitOnError(err, s.logger)
+		go s.checkpointer.Run(ctx)
+	}
+
 	dynamicKubeInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(client.dynamicCli, s.config.InformerResyncPeriod)
 	router := NewRouter(client.mapper, client.dynamicCli, s.logger)
 	router.BuildTable(&s.config)
@@ -111,7 +134,23 @@ func consumeEvents(ctx context.Context, s Source) {
 	s.commandGuard = command.NewCommandGuard(s.logger.WithField(componentLogFieldKey, "Command Guard"), client.discoveryCli)
 	cmdr := commander.NewCommander(s.logger.WithField(componentLogFieldKey, "Commander"), s.commandGuard, s.config.Commands)
 	s.messageBuilder = NewMessageBuilder(s.isInteractivitySupported, s.logger.WithField(componentLogFieldKey, "Message Builder"), cmdr)
-	s.filterEngine = filterengine.WithAllFilters(s.logger, client.dynamicCli, client.mapper, s.config.Filters)
+	s.filterEngine, err = filterengine.WithAllFilters(s.logger, client.dynamicCli, client.mapper, s.config.Filters)
+	exitOnError(err, s.logger)
+	if s.config.Threading.Enabled {
+		s.correlationResolver = NewCorrelationKeyResolver(s.logger.WithField(componentLogFieldKey, "Correlation Key Resolver"), dynamicKubeInformerFactory, client.mapper, ctx.Done())
+	}
+
+	if s.config.Digest.Enabled {
+		if s.config.Digest.Window <= 0 {
+			exitOnError(fmt.Errorf("digest window must be greater than zero, got %s", s.config.Digest.Window), s.logger)
+		}
+		s.digest = NewDigest(s.config.Digest.TopN, time.Now())
+		go sendDigests(ctx, s)
+	}
+
+	if s.config.LivingMessages.Enabled {
+		s.timeline = NewTimeline(s.config.LivingMessages)
+	}
 
 	err = router.RegisterInformers([]config.EventType{
 		config.CreateEvent,
@@ -184,8 +223,8 @@ func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []str
 	s.logger.Debugf("Processing %s to %s/%v in %s namespace", e.Type, e.Resource, e.Name, e.Namespace)
 	enrichEventWithAdditionalMetadata(s, &e)
 
-	// Skip older events
-	if !e.TimeStamp.IsZero() && e.TimeStamp.Before(s.startTime) {
+	// Skip older or already emitted events
+	if s.shouldSkipOlderEvent(e) {
 		s.logger.Debug("Skipping older events")
 		return
 	}
@@ -219,18 +258,85 @@ func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []str
 		return
 	}
 
+	if s.digest != nil {
+		s.digest.Add(e)
+		return
+	}
+
 	msg, err := s.messageBuilder.FromEvent(e, s.config.ExtraButtons)
 	if err != nil {
 		s.logger.Errorf("while rendering message from event: %w", err)
 		return
 	}
 
+	var livingMessageKey string
+	if s.timeline != nil && s.timeline.Tracks(e) {
+		livingMessageKey = LivingMessageKey(e)
+		msg = s.messageBuilder.WithTimeline(msg, s.timeline.Record(livingMessageKey, e))
+	}
+
+	var correlationKey string
+	if s.correlationResolver != nil {
+		correlationKey = s.correlationResolver.Resolve(ctx, e)
+	}
+
 	message := source.Event{
-		Message:         msg,
-		RawObject:       e,
-		AnalyticsLabels: event.AnonymizedEventDetailsFrom(e),
+		Message:          msg,
+		RawObject:        e,
+		AnalyticsLabels:  event.AnonymizedEventDetailsFrom(e),
+		CorrelationKey:   correlationKey,
+		NotificationKey:  NotificationKey(e),
+		LivingMessageKey: livingMessageKey,
 	}
 	s.eventCh <- message
+
+	if s.checkpointer != nil {
+		s.checkpointer.Record(e.TimeStamp, checkpointKey(e))
+	}
+}
+
+// sendDigests periodically sends a message which summarizes accumulated events.
+func sendDigests(ctx context.Context, s Source) {
+	ticker := time.NewTicker(s.config.Digest.Window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			summary := s.digest.Flush(now)
+			if summary.Total == 0 {
+				s.logger.Debug("No events in the digest window. Skipping...")
+				continue
+			}
+
+			s.eventCh <- source.Event{
+				Message: s.messageBuilder.FromDigest(summary, s.clusterName),
+			}
+
+			if s.checkpointer == nil {
+				continue
+			}
+			s.checkpointer.Record(summary.lastTimestamp, summary.lastKeys...)
+		}
+	}
+}
+
+// shouldSkipOlderEvent returns true for events which happened before the plugin start.
+// If checkpoint is enabled, the plugin resumes from the last emitted event instead.
+func (s Source) shouldSkipOlderEvent(e event.Event) bool {
+	if e.TimeStamp.IsZero() {
+		return false
+	}
+	if s.checkpointer != nil {
+		return s.checkpointer.ShouldSkip(e.TimeStamp, checkpointKey(e))
+	}
+	return e.TimeStamp.Before(s.startTime)
+}
+
+func checkpointKey(e event.Event) string {
+	return strings.Join([]string{string(e.Type), e.Resource, e.Namespace, e.Name, e.Reason}, "/")
 }
 
 func enrichEventWithAdditionalMetadata(s Source, event *event.Event) {
@@ -547,6 +653,108 @@ func jsonSchema() api.JSONSchema {
 					  "type": "boolean",
 					  "description": "If true, notifies about Pod resources created without labels.",
 					  "default": true
+					},
+					"resourcesSet": {
+					  "title": "Resources set",
+					  "type": "boolean",
+					  "description": "If true, notifies about Pod containers without resource requests or limits.",
+					  "default": false
+					},
+					"probesSet": {
+					  "title": "Probes set",
+					  "type": "boolean",
+					  "description": "If true, notifies about Pod containers without liveness or readiness probes.",
+					  "default": false
+					},
+					"noPrivilegedContainers": {
+					  "title": "No privileged containers",
+					  "type": "boolean",
+					  "description": "If true, notifies about Pod containers running in privileged mode.",
+					  "default": false
+					},
+					"noHostPathVolumes": {
+					  "title": "No hostPath volumes",
+					  "type": "boolean",
+					  "description": "If true, notifies about Pod resources which mount hostPath volumes.",
+					  "default": false
+					}
+				  }
+				},
+				"deployment": {
+				  "title": "Deployment Recommendations",
+				  "description": "Recommendations for Deployment Kubernetes resource.",
+				  "type": "object",
+				  "additionalProperties": false,
+				  "properties": {
+					"podDisruptionBudgetSet": {
+					  "title": "PodDisruptionBudget set",
+					  "type": "boolean",
+					  "description": "If true, notifies about single-replica Deployments not covered by any PodDisruptionBudget.",
+					  "default": false
+					}
+				  }
+				},
+				"service": {
+				  "title": "Service Recommendations",
+				  "description": "Recommendations for Service Kubernetes resource.",
+				  "type": "object",
+				  "additionalProperties": false,
+				  "properties": {
+					"endpointsExist": {
+					  "title": "Endpoints exist",
+					  "type": "boolean",
+					  "description": "If true, notifies about Services which selector doesn't match any Pod.",
+					  "default": false
+					}
+				  }
+				},
+				"hpa": {
+				  "title": "HorizontalPodAutoscaler Recommendations",
+				  "description": "Recommendations for HorizontalPodAutoscaler Kubernetes resource.",
+				  "type": "object",
+				  "additionalProperties": false,
+				  "properties": {
+					"scaleTargetExists": {
+					  "title": "Scale target exists",
+					  "type": "boolean",
+					  "description": "If true, notifies about HorizontalPodAutoscalers which scale target doesn't exist.",
+					  "default": false
+					}
+				  }
+				},
+				"custom": {
+				  "title": "Custom Recommendations",
+				  "description": "User-defined recommendations. The message is added to the created resource event if the CEL expression evaluated against the object returns true.",
+				  "type": "array",
+				  "items": {
+					"type": "object",
+					"additionalProperties": false,
+					"required": ["name", "resource", "expression", "message"],
+					"properties": {
+					  "name": {
+						"type": "string",
+						"title": "Name"
+					  },
+					  "resource": {
+						"type": "string",
+						"title": "Resource",
+						"description": "Resource type, e.g. apps/v1/deployments."
+					  },
+					  "expression": {
+						"type": "string",
+						"title": "Expression",
+						"description": "CEL expression evaluated against the Kubernetes object, e.g. object.spec.replicas < 2"
+					  },
+					  "message": {
+						"type": "string",
+						"title": "Message"
+					  },
+					  "severity": {
+						"type": "string",
+						"title": "Severity",
+						"enum": ["info", "error"],
+						"default": "info"
+					  }
 					}
 				  }
 				},
@@ -905,6 +1113,14 @@ func jsonSchema() api.JSONSchema {
 				  "title": "Node Events Checker",
 				  "description": "If true, filters out Node-related events that are not important.",
 				  "default": true
+				},
+				"expressions": {
+				  "type": "array",
+				  "title": "Expressions",
+				  "description": "CEL expressions evaluated against the Kubernetes object (\"object\") and the event details (\"event\"). Events are sent only if all expressions evaluate to true.",
+				  "items": {
+					"type": "string"
+				  }
 				}
 			  }
 			},
@@ -913,6 +1129,107 @@ func jsonSchema() api.JSONSchema {
 			  "type": "string",
 			  "default": "30m"
 			},
+			"checkpoint": {
+			  "title": "Checkpoint",
+			  "description": "Persists the last emitted event, so after a restart the plugin resumes from where it left off without sending the same notifications again.",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "type": "boolean",
+				  "default": false
+				},
+				"configMap": {
+				  "title": "ConfigMap",
+				  "description": "ConfigMap where the checkpoint is stored. Requires RBAC configuration which allows the plugin to get, create and update the ConfigMap.",
+				  "type": "object",
+				  "properties": {
+					"name": {
+					  "title": "Name",
+					  "type": "string"
+					},
+					"namespace": {
+					  "title": "Namespace",
+					  "type": "string"
+					}
+				  }
+				},
+				"key": {
+				  "title": "ConfigMap key",
+				  "description": "Key under which the checkpoint is stored in the ConfigMap. Sources which share the ConfigMap must use different keys.",
+				  "type": "string",
+				  "default": "kubernetes"
+				},
+				"fileName": {
+				  "title": "File name",
+				  "description": "Local file where the checkpoint is stored. Used if the ConfigMap name is not specified.",
+				  "type": "string"
+				},
+				"maxAge": {
+				  "title": "Max age",
+				  "description": "Limits how far back in time the plugin resumes after a restart.",
+				  "type": "string",
+				  "default": "1h"
+				},
+				"flushInterval": {
+				  "title": "Flush interval",
+				  "description": "Defines how often the checkpoint is persisted.",
+				  "type": "string",
+				  "default": "1s"
+				}
+			  }
+			},
+			"digest": {
+			  "title": "Digest",
+			  "description": "If enabled, matching events are not sent in real time, but summarized in a single message once per window. Bind a separate source with digest enabled to channels which want only a summary.",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "type": "boolean",
+				  "default": false
+				},
+				"window": {
+				  "title": "Window",
+				  "description": "Time over which events are accumulated.",
+				  "type": "string",
+				  "default": "1h"
+				},
+				"topN": {
+				  "title": "Top offenders",
+				  "description": "Number of the most frequently reported objects listed in the digest.",
+				  "type": "integer",
+				  "default": 5
+				}
+			  }
+			},
+			"livingMessages": {
+			  "title": "Living messages",
+			  "description": "If enabled, the first event for a given object posts a message, and subsequent events edit it in place and extend its status timeline. Supported on Slack, Mattermost and Discord. On other platforms, a new message is posted for each event.",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "type": "boolean",
+				  "default": false
+				},
+				"kinds": {
+				  "title": "Kinds",
+				  "description": "Object kinds for which messages are edited in place.",
+				  "type": "array",
+				  "items": {
+					"type": "string"
+				  },
+				  "default": ["Deployment", "Job"]
+				},
+				"timelineLength": {
+				  "title": "Timeline length",
+				  "description": "Number of the most recent status changes shown in the message.",
+				  "type": "integer",
+				  "default": 10
+				}
+			  }
+			},
 			"log": {
 			  "title": "Logging",
 			  "description": "Logging configuration for the plugin.",
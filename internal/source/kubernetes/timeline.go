@@ -0,0 +1,100 @@
+package kubernetes
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+// timelineMaxObjects limits the number of objects for which status changes are tracked.
+const timelineMaxObjects = 1000
+
+// TimelineEntry describes a single status change of a given object.
+type TimelineEntry struct {
+	TimeStamp time.Time
+	Level     config.Level
+	Type      config.EventType
+	Reason    string
+	Message   string
+}
+
+// Timeline tracks status changes of objects for which messages are edited in place.
+type Timeline struct {
+	kinds  map[string]struct{}
+	length int
+
+	mu      sync.Mutex
+	objects map[string][]TimelineEntry
+	keys    []string
+}
+
+// NewTimeline returns a new Timeline instance.
+func NewTimeline(cfg config.LivingMessages) *Timeline {
+	kinds := map[string]struct{}{}
+	for _, kind := range cfg.Kinds {
+		kinds[strings.ToLower(kind)] = struct{}{}
+	}
+	return &Timeline{
+		kinds:   kinds,
+		length:  cfg.TimelineLength,
+		objects: map[string][]TimelineEntry{},
+	}
+}
+
+// Tracks returns true if messages for a given event object should be edited in place.
+func (t *Timeline) Tracks(e event.Event) bool {
+	_, found := t.kinds[strings.ToLower(e.Kind)]
+	return found
+}
+
+// Record adds a given event to the object timeline and returns the most recent status changes.
+// Once the object is deleted, its timeline is forgotten, so a re-created object starts with an empty timeline.
+func (t *Timeline) Record(key string, e event.Event) []TimelineEntry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry := TimelineEntry{
+		TimeStamp: e.TimeStamp,
+		Level:     e.Level,
+		Type:      e.Type,
+		Reason:    e.Reason,
+	}
+	if len(e.Messages) > 0 {
+		entry.Message = e.Messages[0]
+	}
+
+	entries, found := t.objects[key]
+	if !found {
+		t.keys = append(t.keys, key)
+	}
+	entries = append(entries, entry)
+	if t.length > 0 && len(entries) > t.length {
+		entries = entries[len(entries)-t.length:]
+	}
+
+	out := append([]TimelineEntry{}, entries...)
+	if e.Type == config.DeleteEvent {
+		t.forget(key)
+		return out
+	}
+
+	t.objects[key] = entries
+	for len(t.keys) > timelineMaxObjects {
+		delete(t.objects, t.keys[0])
+		t.keys = t.keys[1:]
+	}
+	return out
+}
+
+func (t *Timeline) forget(key string) {
+	delete(t.objects, key)
+	for idx, k := range t.keys {
+		if k == key {
+			t.keys = append(t.keys[:idx], t.keys[idx+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestTimelineRecord(t *testing.T) {
+	// given
+	timeline := NewTimeline(config.LivingMessages{Kinds: []string{"Deployment"}, TimelineLength: 2})
+	created := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	deploy := event.Event{Kind: "Deployment", Namespace: "default", Name: "nginx"}
+	key := NotificationKey(deploy)
+
+	withType := func(e event.Event, eventType config.EventType, offset time.Duration) event.Event {
+		e.Type = eventType
+		e.Level = event.LevelMap[eventType]
+		e.TimeStamp = created.Add(offset)
+		return e
+	}
+
+	// when
+	timeline.Record(key, withType(deploy, config.CreateEvent, 0))
+	timeline.Record(key, withType(deploy, config.UpdateEvent, time.Minute))
+	entries := timeline.Record(key, withType(deploy, config.UpdateEvent, 2*time.Minute))
+
+	// then
+	assert.True(t, timeline.Tracks(deploy))
+	assert.False(t, timeline.Tracks(event.Event{Kind: "Pod"}))
+	assert.Equal(t, []TimelineEntry{
+		{TimeStamp: created.Add(time.Minute), Level: config.Info, Type: config.UpdateEvent},
+		{TimeStamp: created.Add(2 * time.Minute), Level: config.Info, Type: config.UpdateEvent},
+	}, entries)
+
+	// when
+	deleted := timeline.Record(key, withType(deploy, config.DeleteEvent, 3*time.Minute))
+	recreated := timeline.Record(key, withType(deploy, config.CreateEvent, 4*time.Minute))
+
+	// then
+	require.Len(t, deleted, 2)
+	assert.Equal(t, config.DeleteEvent, deleted[1].Type)
+	assert.Equal(t, []TimelineEntry{
+		{TimeStamp: created.Add(4 * time.Minute), Level: config.Success, Type: config.CreateEvent},
+	}, recreated)
+}
+
+func TestLivingMessageKey(t *testing.T) {
+	// given
+	deploy := event.Event{Kind: "Deployment", Namespace: "default", Name: "nginx", ObjectUID: "6f1a2b3c"}
+	recreated := deploy
+	recreated.ObjectUID = "9d8e7f6a"
+
+	// when
+	key := LivingMessageKey(deploy)
+
+	// then
+	assert.Equal(t, "kubernetes/Deployment/default/nginx/6f1a2b3c", key)
+	assert.NotEqual(t, key, LivingMessageKey(recreated))
+}
+
+func TestMessageBuilderWithTimeline(t *testing.T) {
+	// given
+	builder := NewMessageBuilder(true, loggerx.NewNoop(), nil)
+	msg := api.Message{
+		Sections: []api.Section{
+			{Base: api.Base{Header: "💡 deployments default/nginx has been updated"}},
+		},
+	}
+	created := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	entries := []TimelineEntry{
+		{TimeStamp: created, Level: config.Success, Type: config.CreateEvent},
+		{TimeStamp: created.Add(time.Minute), Level: config.Error, Type: config.ErrorEvent, Reason: "ProgressDeadlineExceeded", Message: "ReplicaSet has timed out progressing."},
+	}
+
+	// when
+	out := builder.WithTimeline(msg, entries)
+
+	// then
+	require.Len(t, out.Sections[0].BulletLists, 1)
+	assert.Equal(t, api.BulletList{
+		Title: "Status timeline",
+		Items: []string{
+			"🟢 12:00:00 create",
+			"❗ 12:01:00 error (ProgressDeadlineExceeded): ReplicaSet has timed out progressing.",
+		},
+	}, out.Sections[0].BulletLists[0])
+	assert.Empty(t, msg.Sections[0].BulletLists, "the input message is not modified")
+}
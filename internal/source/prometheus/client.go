@@ -41,6 +41,11 @@ func (a *alert) IsValid(request GetAlertsRequest) bool {
 	return false
 }
 
+// Key returns the alert identifier based on its labels.
+func (a *alert) Key() string {
+	return fmt.Sprintf("%+v", a.Labels)
+}
+
 // NewClient initializes Prometheus client
 func NewClient(url string) (*Client, error) {
 	c, err := promClient.NewClient(promClient.Config{
@@ -56,23 +61,50 @@ func NewClient(url string) (*Client, error) {
 	}, nil
 }
 
-// Alerts returns only new alerts.
+// Alerts returns only new alerts. Alerts missing from Prometheus, e.g. resolved ones, are forgotten,
+// so they are returned again if they start firing later.
 func (c *Client) Alerts(ctx context.Context, request GetAlertsRequest) ([]alert, error) {
 	alerts, err := c.API.Alerts(ctx)
 	if err != nil {
 		return nil, err
 	}
+	present := map[string]struct{}{}
 	var newAlerts []alert
 	for _, al := range alerts.Alerts {
 		a := alert(al)
+		key := a.Key()
+		present[key] = struct{}{}
 		if !a.IsValid(request) {
 			continue
 		}
-		key := fmt.Sprintf("%+v", a.Labels)
 		if value, ok := c.alerts.Load(key); !ok || a.State != value.(alert).State {
 			newAlerts = append(newAlerts, a)
 			c.alerts.Store(key, a)
 		}
 	}
+
+	c.alerts.Range(func(key, _ any) bool {
+		if _, found := present[key.(string)]; !found {
+			c.alerts.Delete(key)
+		}
+		return true
+	})
 	return newAlerts, nil
 }
+
+// Keys returns keys of all tracked alerts.
+func (c *Client) Keys() []string {
+	var out []string
+	c.alerts.Range(func(key, _ any) bool {
+		out = append(out, key.(string))
+		return true
+	})
+	return out
+}
+
+// RestoreStates marks alerts with given states as already returned, e.g. before the plugin restart.
+func (c *Client) RestoreStates(states map[string]string) {
+	for key, state := range states {
+		c.alerts.Store(key, alert{State: promApi.AlertState(state)})
+	}
+}
@@ -2,28 +2,63 @@ package prometheus
 
 import (
 	"fmt"
+	"time"
 
 	promApi "github.com/prometheus/client_golang/api/prometheus/v1"
 
 	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/checkpoint"
 	"github.com/kubeshop/botkube/pkg/api/source"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/pluginx"
 )
 
+// Mode defines how alerts are received.
+type Mode string
+
+const (
+	// PollMode polls alerts from Prometheus periodically.
+	PollMode Mode = "poll"
+	// WebhookMode exposes an HTTP endpoint implementing the Alertmanager webhook receiver protocol.
+	WebhookMode Mode = "webhook"
+)
+
 // Config prometheus configuration
 type Config struct {
+	Mode            Mode                 `yaml:"mode,omitempty"`
 	URL             string               `yaml:"url,omitempty"`
 	AlertStates     []promApi.AlertState `yaml:"alertStates,omitempty"`
 	IgnoreOldAlerts *bool                `yaml:"ignoreOldAlerts,omitempty"`
+	Webhook         Webhook              `yaml:"webhook,omitempty"`
+	Checkpoint      checkpoint.Config    `yaml:"checkpoint"`
 	Log             config.Logger        `yaml:"log"`
 }
 
+// Webhook holds configuration for the Alertmanager webhook receiver.
+type Webhook struct {
+	// Port on which the receiver listens.
+	Port int `yaml:"port,omitempty"`
+	// Path is the URL path configured in the Alertmanager `webhook_configs`.
+	Path string `yaml:"path,omitempty"`
+	// BearerToken is compared with the token from the `Authorization: Bearer <token>` header. If empty, requests are not authenticated.
+	BearerToken string `yaml:"bearerToken,omitempty"`
+}
+
 // MergeConfigs merges all input configuration.
 func MergeConfigs(configs []*source.Config) (Config, error) {
 	defaults := Config{
+		Mode:            PollMode,
 		AlertStates:     []promApi.AlertState{promApi.AlertStateFiring, promApi.AlertStatePending, promApi.AlertStateInactive},
 		IgnoreOldAlerts: ptr.FromType(true),
+		Webhook: Webhook{
+			Port: 2115,
+			Path: "/alerts",
+		},
+		Checkpoint: checkpoint.Config{
+			Key:           "prometheus",
+			MaxAge:        time.Hour,
+			FlushInterval: time.Second,
+		},
 	}
 
 	var out Config
@@ -2,13 +2,19 @@ package prometheus
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/kubeshop/botkube/internal/httpx"
 	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/checkpoint"
 	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/api/source"
 )
@@ -17,7 +23,7 @@ const (
 	// PluginName is the name of the Prometheus Botkube plugin.
 	PluginName = "prometheus"
 
-	description = "Get notifications about alerts polled from configured Prometheus AlertManager."
+	description = "Get notifications about alerts polled from Prometheus or received from Alertmanager via webhook."
 
 	pollPeriodInSeconds = 5
 )
@@ -26,6 +32,7 @@ const (
 type Source struct {
 	pluginVersion string
 	startedAt     time.Time
+	servers       *httpx.ServerPool
 }
 
 // NewSource returns a new instance of Source.
@@ -33,6 +40,7 @@ func NewSource(version string) *Source {
 	return &Source{
 		pluginVersion: version,
 		startedAt:     time.Now(),
+		servers:       httpx.NewServerPool(),
 	}
 }
 
@@ -43,7 +51,20 @@ func (p *Source) Stream(ctx context.Context, input source.StreamInput) (source.S
 	if err != nil {
 		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
 	}
-	go p.consumeAlerts(ctx, config, out.Event)
+
+	switch config.Mode {
+	case PollMode:
+		if config.URL == "" {
+			return source.StreamOutput{}, errors.New("the Prometheus URL is required in poll mode")
+		}
+		go p.consumeAlerts(ctx, config, input.Context.KubeConfig, input.Context.IsInteractivitySupported, out.Event)
+	case WebhookMode:
+		if err := p.receiveAlerts(ctx, config, input.Context.IsInteractivitySupported, out.Event); err != nil {
+			return source.StreamOutput{}, fmt.Errorf("while starting webhook receiver: %w", err)
+		}
+	default:
+		return source.StreamOutput{}, fmt.Errorf("unknown mode %q", config.Mode)
+	}
 
 	return out, nil
 }
@@ -57,15 +78,26 @@ func (p *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
 	}, nil
 }
 
-func (p *Source) consumeAlerts(ctx context.Context, cfg Config, ch chan<- source.Event) {
+func (p *Source) consumeAlerts(ctx context.Context, cfg Config, kubeConfig []byte, isInteractivitySupported bool, ch chan<- source.Event) {
 	log := loggerx.New(cfg.Log)
 	prometheus, err := NewClient(cfg.URL)
 	exitOnError(err, log)
 
+	minAlertTime := p.startedAt
+	var checkpointer *checkpoint.Checkpointer
+	if cfg.Checkpoint.Enabled {
+		checkpointer, err = p.newCheckpointer(ctx, log, cfg.Checkpoint, kubeConfig)
+		exitOnError(err, log)
+		prometheus.RestoreStates(checkpointer.State())
+		minAlertTime = checkpointer.Since()
+		go checkpointer.Run(ctx)
+	}
+
 	for {
+		polledAt := time.Now()
 		alerts, err := prometheus.Alerts(ctx, GetAlertsRequest{
 			IgnoreOldAlerts: *cfg.IgnoreOldAlerts,
-			MinAlertTime:    p.startedAt,
+			MinAlertTime:    minAlertTime,
 			AlertStates:     cfg.AlertStates,
 		})
 		if err != nil {
@@ -93,16 +125,64 @@ func (p *Source) consumeAlerts(ctx context.Context, cfg Config, ch chan<- source
 					},
 				},
 			}
+			key := notificationKey(string(alert.Labels["alertname"]), alert.Labels.Fingerprint().String())
+			if isInteractivitySupported && key != "" {
+				msg.Type = api.DefaultMessage
+				msg.Sections = append(msg.Sections, api.NotificationActionsSection(key))
+			}
 			ch <- source.Event{
-				Message:   msg,
-				RawObject: alert,
+				Message:         msg,
+				RawObject:       alert,
+				NotificationKey: key,
 			}
+			if checkpointer != nil {
+				checkpointer.SetState(alert.Key(), string(alert.State))
+			}
+		}
+		if checkpointer != nil && err == nil {
+			checkpointer.RetainState(prometheus.Keys())
+			checkpointer.Record(polledAt)
 		}
 		// Fetch alerts periodically with given frequency
 		time.Sleep(time.Second * pollPeriodInSeconds)
 	}
 }
 
+func (p *Source) newCheckpointer(ctx context.Context, log logrus.FieldLogger, cfg checkpoint.Config, kubeConfig []byte) (*checkpoint.Checkpointer, error) {
+	var k8sCli kubernetes.Interface
+	if len(kubeConfig) > 0 {
+		restCfg, err := clientcmd.RESTConfigFromKubeConfig(kubeConfig)
+		if err != nil {
+			return nil, fmt.Errorf("while reading kube config: %w", err)
+		}
+		k8sCli, err = kubernetes.NewForConfig(restCfg)
+		if err != nil {
+			return nil, fmt.Errorf("while creating K8s clientset: %w", err)
+		}
+	}
+
+	store, err := checkpoint.NewStore(cfg, k8sCli)
+	if err != nil {
+		return nil, fmt.Errorf("while creating checkpoint store: %w", err)
+	}
+	return checkpoint.New(ctx, log.WithField("component", "Checkpointer"), cfg, store, p.startedAt)
+}
+
+// receiveAlerts registers the Alertmanager webhook handler. All streams run in a single plugin process,
+// so sources configured with the same port share a single HTTP server.
+func (p *Source) receiveAlerts(ctx context.Context, cfg Config, isInteractivitySupported bool, ch chan<- source.Event) error {
+	log := loggerx.New(cfg.Log)
+
+	// the same source is streamed separately for interactive and non-interactive platforms, so such streams can share the path
+	owner, err := yaml.Marshal(cfg.Webhook)
+	if err != nil {
+		return fmt.Errorf("while marshaling webhook configuration: %w", err)
+	}
+
+	handler := NewWebhookHandler(log, cfg.Webhook, isInteractivitySupported, ch)
+	return p.servers.Handle(ctx, log, cfg.Webhook.Port, cfg.Webhook.Path, string(owner), handler)
+}
+
 func jsonSchema() api.JSONSchema {
 	return api.JSONSchema{
 		Value: heredoc.Docf(`{
@@ -111,9 +191,25 @@ func jsonSchema() api.JSONSchema {
 		  "description": "%s",
 		  "type": "object",
 		  "properties": {
+			"mode": {
+			  "title": "Mode",
+			  "description": "Defines how alerts are received.",
+			  "type": "string",
+			  "default": "poll",
+			  "oneOf": [
+				{
+				  "const": "poll",
+				  "title": "Poll alerts from Prometheus"
+				},
+				{
+				  "const": "webhook",
+				  "title": "Receive alerts from Alertmanager webhook"
+				}
+			  ]
+			},
 			"url": {
 			  "title": "Endpoint",
-			  "description": "Prometheus endpoint without API version and resource.",
+			  "description": "Prometheus endpoint without API version and resource. Required in poll mode.",
 			  "type": "string",
 			  "format": "uri"
 			},
@@ -153,6 +249,80 @@ func jsonSchema() api.JSONSchema {
 			  "uniqueItems": true,
 			  "minItems": 1
 			},
+			"webhook": {
+			  "title": "Webhook",
+			  "description": "Alertmanager webhook receiver configuration. Used only in webhook mode.",
+			  "type": "object",
+			  "properties": {
+				"port": {
+				  "title": "Port",
+				  "description": "Port on which the webhook receiver listens. Sources with the same port share the receiver, so they must use different paths.",
+				  "type": "integer",
+				  "default": 2115
+				},
+				"path": {
+				  "title": "Path",
+				  "description": "URL path configured in the Alertmanager webhook_configs.",
+				  "type": "string",
+				  "default": "/alerts"
+				},
+				"bearerToken": {
+				  "title": "Bearer token",
+				  "description": "Token expected in the 'Authorization: Bearer <token>' header. Configure it as the 'http_config.authorization.credentials' in the Alertmanager webhook_configs.",
+				  "type": "string"
+				}
+			  }
+			},
+			"checkpoint": {
+			  "title": "Checkpoint",
+			  "description": "Persists the last emitted alerts, so after a restart the plugin resumes from where it left off without sending the same notifications again. Used only in poll mode.",
+			  "type": "object",
+			  "properties": {
+				"enabled": {
+				  "title": "Enabled",
+				  "type": "boolean",
+				  "default": false
+				},
+				"configMap": {
+				  "title": "ConfigMap",
+				  "description": "ConfigMap where the checkpoint is stored. Requires RBAC configuration which allows the plugin to get, create and update the ConfigMap.",
+				  "type": "object",
+				  "properties": {
+					"name": {
+					  "title": "Name",
+					  "type": "string"
+					},
+					"namespace": {
+					  "title": "Namespace",
+					  "type": "string"
+					}
+				  }
+				},
+				"key": {
+				  "title": "ConfigMap key",
+				  "description": "Key under which the checkpoint is stored in the ConfigMap. Sources which share the ConfigMap must use different keys.",
+				  "type": "string",
+				  "default": "prometheus"
+				},
+				"fileName": {
+				  "title": "File name",
+				  "description": "Local file where the checkpoint is stored. Used if the ConfigMap name is not specified.",
+				  "type": "string"
+				},
+				"maxAge": {
+				  "title": "Max age",
+				  "description": "Limits how far back in time the plugin resumes after a restart.",
+				  "type": "string",
+				  "default": "1h"
+				},
+				"flushInterval": {
+				  "title": "Flush interval",
+				  "description": "Defines how often the checkpoint is persisted.",
+				  "type": "string",
+				  "default": "1s"
+				}
+			  }
+			},
 			"log": {
 			  "title": "Logging",
 			  "description": "Logging configuration for the plugin.",
@@ -202,8 +372,7 @@ func jsonSchema() api.JSONSchema {
 				}
 			  }
 			}
-		  },
-		  "required": ["url"]
+		  }
 		}`, description),
 	}
 }
@@ -0,0 +1,320 @@
+package prometheus
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const (
+	alertStatusFiring   = "firing"
+	alertStatusResolved = "resolved"
+
+	runbookURLAnnotation = "runbook_url"
+
+	// maxPayloadBytes limits the size of Alertmanager webhook payloads. It's higher than for generic webhooks,
+	// as a single alert group can contain many alerts.
+	maxPayloadBytes = 4 << 20 // 4 MiB
+)
+
+// WebhookMessage is the payload sent by Alertmanager to webhook receivers.
+// See: https://prometheus.io/docs/alerting/latest/configuration/#webhook_config
+type WebhookMessage struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	TruncatedAlerts   int               `json:"truncatedAlerts"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []WebhookAlert    `json:"alerts"`
+}
+
+// WebhookAlert is a single alert sent by Alertmanager.
+type WebhookAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// WebhookHandler implements the Alertmanager webhook receiver protocol.
+type WebhookHandler struct {
+	log                      logrus.FieldLogger
+	cfg                      Webhook
+	isInteractivitySupported bool
+	ch                       chan<- source.Event
+}
+
+// NewWebhookHandler returns a new WebhookHandler instance.
+func NewWebhookHandler(log logrus.FieldLogger, cfg Webhook, isInteractivitySupported bool, ch chan<- source.Event) *WebhookHandler {
+	return &WebhookHandler{
+		log:                      log,
+		cfg:                      cfg,
+		isInteractivitySupported: isInteractivitySupported,
+		ch:                       ch,
+	}
+}
+
+// ServeHTTP handles alert notifications sent by Alertmanager.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if token := h.cfg.BearerToken; token != "" {
+		got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			h.log.Warn("Rejecting Alertmanager webhook request with invalid bearer token")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	var msg WebhookMessage
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPayloadBytes)).Decode(&msg); err != nil {
+		h.log.WithError(err).Warn("Cannot decode Alertmanager webhook payload")
+		status := http.StatusBadRequest
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, fmt.Sprintf("while decoding payload: %s", err.Error()), status)
+		return
+	}
+
+	for _, event := range h.eventsFrom(msg) {
+		select {
+		case h.ch <- event:
+		case <-r.Context().Done():
+			http.Error(w, "request canceled", http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// eventsFrom returns a single event for the whole alert group on interactive platforms. Platforms with limited
+// rendering capabilities get a separate event for each alert.
+func (h *WebhookHandler) eventsFrom(msg WebhookMessage) []source.Event {
+	if len(msg.Alerts) == 0 {
+		return nil
+	}
+
+	if h.isInteractivitySupported {
+		key := groupNotificationKey(msg)
+		groupMsg := groupMessage(msg)
+		if key != "" {
+			groupMsg.Sections = append(groupMsg.Sections, api.NotificationActionsSection(key))
+		}
+		return []source.Event{
+			{
+				Message:         groupMsg,
+				RawObject:       msg,
+				CorrelationKey:  msg.GroupKey,
+				NotificationKey: key,
+			},
+		}
+	}
+
+	var out []source.Event
+	for _, alert := range msg.Alerts {
+		out = append(out, source.Event{
+			Message:         singleAlertMessage(alert),
+			RawObject:       alert,
+			CorrelationKey:  alert.Fingerprint,
+			NotificationKey: notificationKey(alert.Labels["alertname"], alertFingerprint(alert)),
+		})
+	}
+	return out
+}
+
+// notificationKey returns the key used to acknowledge, snooze and silence notifications for a given alert name
+// and identifier, so suppressing e.g. an alert for one namespace doesn't affect the same alert for other namespaces.
+func notificationKey(alertName, id string) string {
+	if alertName == "" || id == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", PluginName, alertName, id)
+}
+
+// groupNotificationKey returns the notification key for an alert group. Groups with different alert names cannot be suppressed.
+func groupNotificationKey(msg WebhookMessage) string {
+	name := msg.GroupLabels["alertname"]
+	if name == "" {
+		name = msg.CommonLabels["alertname"]
+	}
+	if msg.GroupKey == "" {
+		return ""
+	}
+
+	// group key contains label matchers, e.g. `{}:{alertname="KubePodCrashLooping"}`, so it cannot be used in commands directly
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(msg.GroupKey))
+	return notificationKey(name, fmt.Sprintf("%016x", hash.Sum64()))
+}
+
+// alertFingerprint returns the fingerprint sent by Alertmanager. For older Alertmanager versions, it is computed from the alert labels.
+func alertFingerprint(alert WebhookAlert) string {
+	if alert.Fingerprint != "" {
+		return alert.Fingerprint
+	}
+
+	labels := model.LabelSet{}
+	for key, val := range alert.Labels {
+		labels[model.LabelName(key)] = model.LabelValue(val)
+	}
+	return labels.Fingerprint().String()
+}
+
+func groupMessage(msg WebhookMessage) api.Message {
+	var sections []api.Section
+	for _, alert := range msg.Alerts {
+		section := api.Section{
+			TextFields:  alertTextFields(alert),
+			BulletLists: alertBulletLists(alert),
+			Context: api.ContextItems{
+				{Text: fmt.Sprintf("Labels: %s", formatLabels(alert.Labels))},
+			},
+		}
+
+		btnBuilder := api.NewMessageButtonBuilder()
+		if url := alert.Annotations[runbookURLAnnotation]; url != "" {
+			section.Buttons = append(section.Buttons, btnBuilder.ForURL("Runbook", url, api.ButtonStylePrimary))
+		}
+		if alert.GeneratorURL != "" {
+			section.Buttons = append(section.Buttons, btnBuilder.ForURL("Source", alert.GeneratorURL))
+		}
+		sections = append(sections, section)
+	}
+
+	if msg.TruncatedAlerts > 0 {
+		sections = append(sections, api.Section{
+			Context: api.ContextItems{
+				{Text: fmt.Sprintf("%d more alerts were truncated by Alertmanager.", msg.TruncatedAlerts)},
+			},
+		})
+	}
+
+	if len(sections) > 0 {
+		sections[0].Header = groupHeader(msg)
+	}
+
+	return api.Message{
+		Timestamp: time.Now(),
+		Sections:  sections,
+	}
+}
+
+func singleAlertMessage(alert WebhookAlert) api.Message {
+	textFields := alertTextFields(alert)
+	if url := alert.Annotations[runbookURLAnnotation]; url != "" {
+		textFields = append(textFields, api.TextField{Key: "Runbook", Value: url})
+	}
+
+	return api.Message{
+		Type:      api.NonInteractiveSingleSection,
+		Timestamp: time.Now(),
+		Sections: []api.Section{
+			{
+				Base: api.Base{
+					Header: fmt.Sprintf("%s %s", statusEmoji(alert.Status), alert.Labels["alertname"]),
+				},
+				TextFields:  textFields,
+				BulletLists: alertBulletLists(alert),
+			},
+		},
+	}
+}
+
+func groupHeader(msg WebhookMessage) string {
+	header := fmt.Sprintf("%s [%s:%d]", statusEmoji(msg.Status), strings.ToUpper(msg.Status), len(msg.Alerts))
+	if len(msg.GroupLabels) > 0 {
+		header = fmt.Sprintf("%s %s", header, formatLabels(msg.GroupLabels))
+	}
+	return header
+}
+
+func alertTextFields(alert WebhookAlert) api.TextFields {
+	fields := api.TextFields{
+		{Key: "Source", Value: PluginName},
+		{Key: "Alert Name", Value: alert.Labels["alertname"]},
+		{Key: "State", Value: alert.Status},
+	}
+	if severity := alert.Labels["severity"]; severity != "" {
+		fields = append(fields, api.TextField{Key: "Severity", Value: severity})
+	}
+	if alert.Status == alertStatusResolved && !alert.EndsAt.IsZero() {
+		fields = append(fields, api.TextField{Key: "Resolved At", Value: alert.EndsAt.UTC().Format(time.RFC1123)})
+	} else if !alert.StartsAt.IsZero() {
+		fields = append(fields, api.TextField{Key: "Started At", Value: alert.StartsAt.UTC().Format(time.RFC1123)})
+	}
+	return fields
+}
+
+func alertBulletLists(alert WebhookAlert) api.BulletLists {
+	var items []string
+	for _, key := range sortedKeys(alert.Annotations) {
+		if key == runbookURLAnnotation {
+			continue
+		}
+		items = append(items, fmt.Sprintf("%s: %s", key, alert.Annotations[key]))
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	return api.BulletLists{
+		{
+			Title: "Annotations",
+			Items: items,
+		},
+	}
+}
+
+func formatLabels(labels map[string]string) string {
+	var out []string
+	for _, key := range sortedKeys(labels) {
+		out = append(out, fmt.Sprintf("%s=%s", key, labels[key]))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(out, ", "))
+}
+
+func statusEmoji(status string) string {
+	switch status {
+	case alertStatusFiring:
+		return ":red_circle:"
+	case alertStatusResolved:
+		return ":large_green_circle:"
+	default:
+		return ":warning:"
+	}
+}
+
+func sortedKeys(in map[string]string) []string {
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
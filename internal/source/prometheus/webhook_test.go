@@ -0,0 +1,209 @@
+package prometheus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+var alertmanagerPayload = heredoc.Doc(`
+	{
+	  "version": "4",
+	  "groupKey": "{}:{alertname=\"KubePodCrashLooping\"}",
+	  "truncatedAlerts": 0,
+	  "status": "firing",
+	  "receiver": "botkube",
+	  "groupLabels": {"alertname": "KubePodCrashLooping"},
+	  "commonLabels": {"alertname": "KubePodCrashLooping", "severity": "warning"},
+	  "commonAnnotations": {},
+	  "externalURL": "http://alertmanager:9093",
+	  "alerts": [
+		{
+		  "status": "firing",
+		  "labels": {"alertname": "KubePodCrashLooping", "severity": "warning", "pod": "nginx-1"},
+		  "annotations": {
+			"description": "Pod default/nginx-1 is in waiting state.",
+			"runbook_url": "https://runbooks.prometheus-operator.dev/runbooks/kubernetes/kubepodcrashlooping"
+		  },
+		  "startsAt": "2023-07-07T10:00:00Z",
+		  "endsAt": "0001-01-01T00:00:00Z",
+		  "generatorURL": "http://prometheus:9090/graph?g0.expr=up",
+		  "fingerprint": "5ef77f1f8a3ecfa4"
+		},
+		{
+		  "status": "firing",
+		  "labels": {"alertname": "KubePodCrashLooping", "severity": "warning", "pod": "nginx-2"},
+		  "annotations": {"description": "Pod default/nginx-2 is in waiting state."},
+		  "startsAt": "2023-07-07T10:01:00Z",
+		  "endsAt": "0001-01-01T00:00:00Z",
+		  "generatorURL": "",
+		  "fingerprint": "7be2e2c5b4a1d003"
+		}
+	  ]
+	}`)
+
+func TestWebhookHandlerGroupsAlertsOnInteractivePlatforms(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 2)
+	handler := NewWebhookHandler(loggerx.NewNoop(), Webhook{}, true, ch)
+	req := httptest.NewRequest(http.MethodPost, "/alerts", strings.NewReader(alertmanagerPayload))
+	rec := httptest.NewRecorder()
+
+	// when
+	handler.ServeHTTP(rec, req)
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, ch, 1)
+
+	event := <-ch
+	assert.Equal(t, `{}:{alertname="KubePodCrashLooping"}`, event.CorrelationKey)
+	assert.Equal(t, "prometheus/KubePodCrashLooping/8b2c278d045b626a", event.NotificationKey)
+	require.Len(t, event.Message.Sections, 3)
+
+	first := event.Message.Sections[0]
+	assert.Equal(t, ":red_circle: [FIRING:2] {alertname=KubePodCrashLooping}", first.Header)
+	assert.Contains(t, first.TextFields, api.TextField{Key: "Severity", Value: "warning"})
+	assert.Equal(t, []string{"description: Pod default/nginx-1 is in waiting state."}, first.BulletLists[0].Items)
+	require.Len(t, first.Buttons, 2)
+	assert.Equal(t, "Runbook", first.Buttons[0].Name)
+	assert.Equal(t, "https://runbooks.prometheus-operator.dev/runbooks/kubernetes/kubepodcrashlooping", first.Buttons[0].URL)
+	assert.Equal(t, "http://prometheus:9090/graph?g0.expr=up", first.Buttons[1].URL)
+
+	second := event.Message.Sections[1]
+	assert.Empty(t, second.Header)
+	assert.Empty(t, second.Buttons)
+	assert.Equal(t, "Labels: {alertname=KubePodCrashLooping, pod=nginx-2, severity=warning}", second.Context[0].Text)
+
+	actions := event.Message.Sections[2]
+	assert.Equal(t, api.NotificationActionsSection("prometheus/KubePodCrashLooping/8b2c278d045b626a"), actions)
+}
+
+func TestWebhookHandlerSplitsAlertsOnNonInteractivePlatforms(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 2)
+	handler := NewWebhookHandler(loggerx.NewNoop(), Webhook{}, false, ch)
+	req := httptest.NewRequest(http.MethodPost, "/alerts", strings.NewReader(alertmanagerPayload))
+	rec := httptest.NewRecorder()
+
+	// when
+	handler.ServeHTTP(rec, req)
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, ch, 2)
+
+	first, second := <-ch, <-ch
+	assert.Equal(t, "5ef77f1f8a3ecfa4", first.CorrelationKey)
+	assert.Equal(t, "7be2e2c5b4a1d003", second.CorrelationKey)
+	assert.Equal(t, "prometheus/KubePodCrashLooping/5ef77f1f8a3ecfa4", first.NotificationKey)
+	assert.Equal(t, "prometheus/KubePodCrashLooping/7be2e2c5b4a1d003", second.NotificationKey)
+
+	assert.Equal(t, api.NonInteractiveSingleSection, first.Message.Type)
+	assert.Contains(t, first.Message.Sections[0].TextFields, api.TextField{
+		Key:   "Runbook",
+		Value: "https://runbooks.prometheus-operator.dev/runbooks/kubernetes/kubepodcrashlooping",
+	})
+	assert.Equal(t, "nginx-2", second.RawObject.(WebhookAlert).Labels["pod"])
+}
+
+func TestWebhookHandlerRejectsInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		token   string
+		body    string
+		expCode int
+	}{
+		{
+			name:    "Wrong method",
+			method:  http.MethodGet,
+			expCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:    "Malformed payload",
+			method:  http.MethodPost,
+			token:   "secret",
+			body:    "{",
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name:    "Too large payload",
+			method:  http.MethodPost,
+			token:   "secret",
+			body:    "{" + strings.Repeat(" ", maxPayloadBytes),
+			expCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:    "Missing bearer token",
+			method:  http.MethodPost,
+			body:    alertmanagerPayload,
+			expCode: http.StatusUnauthorized,
+		},
+		{
+			name:    "Invalid bearer token",
+			method:  http.MethodPost,
+			token:   "other",
+			body:    alertmanagerPayload,
+			expCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ch := make(chan source.Event, 1)
+			handler := NewWebhookHandler(loggerx.NewNoop(), Webhook{BearerToken: "secret"}, true, ch)
+			req := httptest.NewRequest(tc.method, "/alerts", strings.NewReader(tc.body))
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+
+			// when
+			handler.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tc.expCode, rec.Code)
+			assert.Len(t, ch, 0)
+		})
+	}
+}
+
+func TestWebhookHandlerAcceptsValidBearerToken(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 1)
+	handler := NewWebhookHandler(loggerx.NewNoop(), Webhook{BearerToken: "secret"}, true, ch)
+	req := httptest.NewRequest(http.MethodPost, "/alerts", strings.NewReader(alertmanagerPayload))
+	req.Header.Set("Authorization", "Bearer secret")
+	rec := httptest.NewRecorder()
+
+	// when
+	handler.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, ch, 1)
+}
+
+func TestAlertFingerprintFallsBackToLabels(t *testing.T) {
+	// given
+	first := WebhookAlert{Labels: map[string]string{"alertname": "KubePodCrashLooping", "namespace": "default"}}
+	second := WebhookAlert{Labels: map[string]string{"alertname": "KubePodCrashLooping", "namespace": "kube-system"}}
+
+	// when
+	firstKey := notificationKey(first.Labels["alertname"], alertFingerprint(first))
+	secondKey := notificationKey(second.Labels["alertname"], alertFingerprint(second))
+
+	// then
+	assert.NotEqual(t, firstKey, secondKey)
+	assert.Equal(t, firstKey, notificationKey(first.Labels["alertname"], alertFingerprint(first)))
+}
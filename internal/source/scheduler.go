@@ -26,6 +26,11 @@ type PluginDispatch struct {
 	pluginContext            config.PluginContext
 }
 
+// threadingPluginConfig holds the source threading settings passed to source plugins.
+type threadingPluginConfig struct {
+	Threading config.EventThreading `yaml:"threading"`
+}
+
 // Scheduler analyzes the provided configuration and based on that schedules plugin sources.
 type Scheduler struct {
 	log        logrus.FieldLogger
@@ -89,6 +94,11 @@ func (d *Scheduler) Start(ctx context.Context) error {
 			if err := d.schedule(ctx, config.TeamsCommPlatformIntegration.IsInteractive(), commGroupCfg.Teams.Bindings.Sources); err != nil {
 				return err
 			}
+			for _, channel := range commGroupCfg.Teams.Channels {
+				if err := d.schedule(ctx, config.TeamsCommPlatformIntegration.IsInteractive(), channel.Bindings.Sources); err != nil {
+					return err
+				}
+			}
 		}
 
 		if commGroupCfg.Discord.Enabled {
@@ -176,6 +186,19 @@ func (d *Scheduler) schedulePlugin(ctx context.Context, isInteractivitySupported
 		})
 	}
 
+	if srcConfig.Threading.Enabled {
+		// Plugins don't know the source settings, so pass the threading settings to let them resolve correlation keys.
+		rawYAML, err := yaml.Marshal(threadingPluginConfig{Threading: srcConfig.Threading})
+		if err != nil {
+			return fmt.Errorf("while marshaling threading config for source %s: %w", sourceName, err)
+		}
+		for pluginName := range sourcePluginConfigs {
+			sourcePluginConfigs[pluginName] = append(sourcePluginConfigs[pluginName], &source.Config{
+				RawYAML: rawYAML,
+			})
+		}
+	}
+
 	for pluginName, configs := range sourcePluginConfigs {
 		err := d.dispatcher.Dispatch(PluginDispatch{
 			ctx:                      ctx,
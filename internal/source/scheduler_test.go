@@ -60,6 +60,48 @@ func TestStartingUniqueProcesses(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSchedulerPassesThreadingConfig(t *testing.T) {
+	// given
+	givenCfg := &config.Config{
+		Communications: map[string]config.Communications{
+			"default-group": {
+				Webhook: config.Webhook{
+					Enabled:  true,
+					Bindings: config.SinkBindings{Sources: []string{"k8s-events"}},
+				},
+			},
+		},
+		Sources: map[string]config.Sources{
+			"k8s-events": {
+				Threading: config.EventThreading{Enabled: true},
+				Plugins: config.Plugins{
+					"botkube/kubernetes": config.Plugin{
+						Enabled: true,
+						Config:  map[string]any{"namespaces": map[string]any{"include": []string{".*"}}},
+					},
+				},
+			},
+		},
+	}
+
+	var gotConfigs []*source.Config
+	starter := func(ctx context.Context, pluginName string, pluginConfigs []*source.Config, sources []string) error {
+		gotConfigs = pluginConfigs
+		return nil
+	}
+
+	// when
+	scheduler := NewScheduler(loggerx.NewNoop(), givenCfg, fakeDispatcherFunc(starter))
+
+	err := scheduler.Start(context.Background())
+	require.NoError(t, err)
+
+	// then
+	require.Len(t, gotConfigs, 2)
+	assert.Equal(t, mustYAMLMarshal(t, givenCfg.Sources["k8s-events"].Plugins["botkube/kubernetes"].Config), gotConfigs[0].RawYAML)
+	assert.Equal(t, "threading:\n    enabled: true\n", string(gotConfigs[1].RawYAML))
+}
+
 func mustYAMLMarshal(t *testing.T, in any) []byte {
 	raw, err := yaml.Marshal(in)
 	require.NoError(t, err)
@@ -0,0 +1,264 @@
+package source
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	sprig "github.com/go-task/slim-sprig"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const rateLimitInterval = time.Minute
+
+// defaultKeyFields are the event fields used as a throttling key if no key template is configured.
+// They identify the involved object and the reason of Kubernetes events.
+var defaultKeyFields = []string{"Kind", "Namespace", "Name", "Reason"}
+
+// rollupFn is called when the deduplication window for a given key is closed and some events were suppressed.
+type rollupFn func(key string, suppressed int)
+
+// budgetRollupFn is called when the messages per minute budget of a given channel is renewed and some events were suppressed.
+type budgetRollupFn func(channel throttledChannel, suppressed int)
+
+// throttledChannel identifies a channel of a given bot notifier.
+type throttledChannel struct {
+	NotifierKey string
+	Channel     string
+}
+
+// eventThrottler deduplicates similar events and limits the number of messages sent per minute to each channel
+// for a single source stream. It throttles only messages sent to communication platforms.
+type eventThrottler struct {
+	log            logrus.FieldLogger
+	cfg            config.EventThrottling
+	keyTpl         *template.Template
+	onRollup       rollupFn
+	onBudgetRollup budgetRollupFn
+	now            func() time.Time
+	budgetInterval time.Duration
+
+	mu      sync.Mutex
+	windows map[string]*throttleWindow
+	budgets map[throttledChannel]*channelBudget
+}
+
+type throttleWindow struct {
+	suppressed int
+	timer      *time.Timer
+}
+
+type channelBudget struct {
+	sentAt     []time.Time
+	suppressed int
+	timer      *time.Timer
+}
+
+type keyRenderingData struct {
+	Event any
+}
+
+func newEventThrottler(log logrus.FieldLogger, cfg config.EventThrottling, onRollup rollupFn, onBudgetRollup budgetRollupFn) (*eventThrottler, error) {
+	var keyTpl *template.Template
+	if cfg.KeyTemplate != "" {
+		tpl, err := template.New("throttling-key").Funcs(sprig.TxtFuncMap()).Parse(cfg.KeyTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing throttling key template %q: %w", cfg.KeyTemplate, err)
+		}
+		keyTpl = tpl
+	}
+
+	return &eventThrottler{
+		log:            log,
+		cfg:            cfg,
+		keyTpl:         keyTpl,
+		onRollup:       onRollup,
+		onBudgetRollup: onBudgetRollup,
+		now:            time.Now,
+		budgetInterval: rateLimitInterval,
+		windows:        map[string]*throttleWindow{},
+		budgets:        map[throttledChannel]*channelBudget{},
+	}, nil
+}
+
+// Allow returns true if a given event is not similar to an event dispatched in the current deduplication window.
+// Suppressed events are counted and reported via the rollup function once the deduplication window is closed.
+func (t *eventThrottler) Allow(event any) bool {
+	if t.cfg.Window <= 0 {
+		return true
+	}
+
+	key, err := t.keyFor(event)
+	if err != nil {
+		// we don't want to lose events because of a broken template
+		t.log.Errorf("while rendering throttling key: %s", err.Error())
+		return true
+	}
+	if key == "" {
+		// the event doesn't have any of the default key fields, so it's not similar to any other event
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if w, found := t.windows[key]; found {
+		w.suppressed++
+		t.log.WithField("key", key).Debug("Suppressing similar event...")
+		return false
+	}
+
+	window := &throttleWindow{}
+	window.timer = time.AfterFunc(t.cfg.Window, func() {
+		t.closeWindow(key)
+	})
+	t.windows[key] = window
+	return true
+}
+
+// LimitsChannels returns true if the number of messages sent to each channel is limited.
+func (t *eventThrottler) LimitsChannels() bool {
+	return t.cfg.MaxMessagesPerMinute > 0
+}
+
+// ReserveChannels returns channels of a given notifier which didn't exceed the messages per minute budget.
+// Suppressed events are counted and reported via the budget rollup function once the channel budget is renewed.
+func (t *eventThrottler) ReserveChannels(notifierKey string, channels []string) []string {
+	if !t.LimitsChannels() {
+		return channels
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var out []string
+	for _, channel := range channels {
+		key := throttledChannel{NotifierKey: notifierKey, Channel: channel}
+		if !t.reserveBudget(key) {
+			t.log.WithField("channel", key.Channel).Debug("Messages per minute budget exceeded. Suppressing event...")
+			continue
+		}
+		out = append(out, channel)
+	}
+	return out
+}
+
+// Stop stops all pending deduplication windows and budget renewals without emitting rollups.
+func (t *eventThrottler) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, w := range t.windows {
+		w.timer.Stop()
+		delete(t.windows, key)
+	}
+	for key, b := range t.budgets {
+		if b.timer != nil {
+			b.timer.Stop()
+		}
+		delete(t.budgets, key)
+	}
+}
+
+func (t *eventThrottler) closeWindow(key string) {
+	t.mu.Lock()
+	w, found := t.windows[key]
+	delete(t.windows, key)
+	t.mu.Unlock()
+
+	if !found || w.suppressed == 0 {
+		return
+	}
+
+	t.onRollup(key, w.suppressed)
+}
+
+func (t *eventThrottler) renewBudget(key throttledChannel) {
+	t.mu.Lock()
+	b, found := t.budgets[key]
+	var suppressed int
+	if found {
+		suppressed = b.suppressed
+		b.suppressed = 0
+		b.timer = nil
+	}
+	t.mu.Unlock()
+
+	if suppressed == 0 {
+		return
+	}
+
+	t.onBudgetRollup(key, suppressed)
+}
+
+// reserveBudget must be called with the mutex held.
+func (t *eventThrottler) reserveBudget(key throttledChannel) bool {
+	b, found := t.budgets[key]
+	if !found {
+		b = &channelBudget{}
+		t.budgets[key] = b
+	}
+
+	now := t.now()
+	idx := 0
+	for idx < len(b.sentAt) && now.Sub(b.sentAt[idx]) >= t.budgetInterval {
+		idx++
+	}
+	b.sentAt = b.sentAt[idx:]
+
+	if len(b.sentAt) < t.cfg.MaxMessagesPerMinute {
+		b.sentAt = append(b.sentAt, now)
+		return true
+	}
+
+	b.suppressed++
+	if b.timer == nil {
+		// report suppressed events once the oldest message leaves the budget interval
+		b.timer = time.AfterFunc(b.sentAt[0].Add(t.budgetInterval).Sub(now), func() {
+			t.renewBudget(key)
+		})
+	}
+	return false
+}
+
+func (t *eventThrottler) keyFor(event any) (string, error) {
+	if t.keyTpl == nil {
+		return defaultKey(event), nil
+	}
+
+	var out bytes.Buffer
+	if err := t.keyTpl.Execute(&out, keyRenderingData{Event: event}); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// defaultKey returns the default key fields of a given event joined with a slash.
+// It returns an empty string if the event has none of them.
+func defaultKey(event any) string {
+	fields, ok := event.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	var (
+		values []string
+		found  bool
+	)
+	for _, name := range defaultKeyFields {
+		val, _ := fields[name].(string)
+		if val != "" {
+			found = true
+		}
+		values = append(values, val)
+	}
+	if !found {
+		return ""
+	}
+	return strings.Join(values, "/")
+}
@@ -0,0 +1,170 @@
+package source
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestEventThrottlerDeduplication(t *testing.T) {
+	// given
+	var (
+		mu      sync.Mutex
+		rollups = map[string]int{}
+		done    = make(chan struct{})
+	)
+	cfg := config.EventThrottling{
+		Enabled:     true,
+		KeyTemplate: "{{ .Event.Kind }}/{{ .Event.Name }}",
+		Window:      50 * time.Millisecond,
+	}
+	throttler, err := newEventThrottler(loggerx.NewNoop(), cfg, func(key string, suppressed int) {
+		mu.Lock()
+		defer mu.Unlock()
+		rollups[key] = suppressed
+		close(done)
+	}, func(throttledChannel, int) {})
+	require.NoError(t, err)
+
+	crashingPod := map[string]any{"Kind": "Pod", "Name": "crashing", "Reason": "BackOff"}
+	otherPod := map[string]any{"Kind": "Pod", "Name": "other"}
+
+	// when
+	first := throttler.Allow(crashingPod)
+	second := throttler.Allow(crashingPod)
+	third := throttler.Allow(crashingPod)
+	other := throttler.Allow(otherPod)
+
+	// then
+	assert.True(t, first)
+	assert.False(t, second)
+	assert.False(t, third)
+	assert.True(t, other)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("rollup was not emitted")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[string]int{"Pod/crashing": 2}, rollups)
+}
+
+func TestEventThrottlerRateLimitPerChannel(t *testing.T) {
+	// given
+	cfg := config.EventThrottling{
+		Enabled:              true,
+		MaxMessagesPerMinute: 2,
+	}
+	throttler, err := newEventThrottler(loggerx.NewNoop(), cfg, func(string, int) {}, func(throttledChannel, int) {})
+	require.NoError(t, err)
+	defer throttler.Stop()
+
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	throttler.now = func() time.Time { return now }
+
+	// when
+	got := [][]string{
+		throttler.ReserveChannels("default-socketSlack", []string{"general", "alerts"}),
+		throttler.ReserveChannels("default-socketSlack", []string{"general"}),
+		throttler.ReserveChannels("default-socketSlack", []string{"general", "alerts"}),
+		throttler.ReserveChannels("default-discord", []string{"general"}),
+	}
+	now = now.Add(time.Minute)
+	got = append(got, throttler.ReserveChannels("default-socketSlack", []string{"general", "alerts"}))
+
+	// then
+	assert.Equal(t, [][]string{
+		{"general", "alerts"},
+		{"general"},
+		{"alerts"},
+		{"general"},
+		{"general", "alerts"},
+	}, got)
+}
+
+func TestEventThrottlerBudgetRollup(t *testing.T) {
+	// given
+	var (
+		mu      sync.Mutex
+		rollups = map[throttledChannel]int{}
+		done    = make(chan struct{})
+	)
+	cfg := config.EventThrottling{
+		Enabled:              true,
+		MaxMessagesPerMinute: 1,
+	}
+	throttler, err := newEventThrottler(loggerx.NewNoop(), cfg, func(string, int) {}, func(channel throttledChannel, suppressed int) {
+		mu.Lock()
+		defer mu.Unlock()
+		rollups[channel] = suppressed
+		close(done)
+	})
+	require.NoError(t, err)
+	throttler.budgetInterval = 50 * time.Millisecond
+
+	// when
+	for i := 0; i < 3; i++ {
+		throttler.ReserveChannels("default-socketSlack", []string{"general"})
+	}
+
+	// then
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("rollup was not emitted")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, map[throttledChannel]int{{NotifierKey: "default-socketSlack", Channel: "general"}: 2}, rollups)
+}
+
+func TestEventThrottlerInvalidKeyTemplate(t *testing.T) {
+	// given
+	cfg := config.EventThrottling{
+		Enabled:     true,
+		KeyTemplate: "{{ .Event.Name ",
+	}
+
+	// when
+	_, err := newEventThrottler(loggerx.NewNoop(), cfg, func(string, int) {}, func(throttledChannel, int) {})
+
+	// then
+	assert.ErrorContains(t, err, "while parsing throttling key template")
+}
+
+func TestEventThrottlerDefaultKey(t *testing.T) {
+	// given
+	cfg := config.EventThrottling{
+		Enabled: true,
+		Window:  time.Minute,
+	}
+	throttler, err := newEventThrottler(loggerx.NewNoop(), cfg, func(string, int) {}, func(throttledChannel, int) {})
+	require.NoError(t, err)
+	defer throttler.Stop()
+
+	crashingPod := func(timestamp string) map[string]any {
+		return map[string]any{"Kind": "Pod", "Namespace": "default", "Name": "crashing", "Reason": "BackOff", "TimeStamp": timestamp}
+	}
+	alert := map[string]any{"status": "firing"}
+
+	// when
+	first := throttler.Allow(crashingPod("2023-07-01T09:00:00Z"))
+	second := throttler.Allow(crashingPod("2023-07-01T09:00:05Z"))
+	firstAlert := throttler.Allow(alert)
+	secondAlert := throttler.Allow(alert)
+
+	// then
+	assert.True(t, first)
+	assert.False(t, second)
+	assert.True(t, firstAlert)
+	assert.True(t, secondAlert)
+	assert.Contains(t, throttler.windows, "Pod/default/crashing/BackOff")
+}
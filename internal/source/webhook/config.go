@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/pluginx"
+)
+
+const defaultSignatureHeader = "X-Signature-256"
+
+// Config holds incoming webhook source configuration.
+type Config struct {
+	// Port on which the webhook server listens.
+	Port int `yaml:"port"`
+	// Path on which the payloads are accepted.
+	Path string `yaml:"path"`
+	// Auth holds optional request authentication. All configured methods must pass.
+	Auth Auth `yaml:"auth"`
+	// Rules define which payloads are emitted as events and how they are rendered.
+	// If not specified, all payloads are emitted with a default message.
+	Rules []Rule        `yaml:"rules"`
+	Log   config.Logger `yaml:"log"`
+}
+
+// Auth holds request authentication configuration.
+type Auth struct {
+	// BearerToken is compared with the token from the `Authorization: Bearer <token>` header.
+	BearerToken string `yaml:"bearerToken"`
+	// HMAC holds the request signature verification.
+	HMAC HMAC `yaml:"hmac"`
+}
+
+// HMAC defines the HMAC-SHA256 signature verification of the request body.
+type HMAC struct {
+	// Secret used to sign the payload. If empty, signature is not verified.
+	Secret string `yaml:"secret"`
+	// Header holds the hex-encoded signature. The optional `sha256=` prefix is trimmed.
+	Header string `yaml:"header"`
+}
+
+// Rule defines a payload matcher together with the notification template.
+type Rule struct {
+	// Name of the rule, used in logs.
+	Name string `yaml:"name"`
+	// JSONPath expression evaluated against the payload. Empty expression matches all payloads.
+	JSONPath string `yaml:"jsonPath"`
+	// Value to match in the JSONPath result.
+	Value string `yaml:"value"`
+	// NotificationTemplate defines how the matched payload is rendered.
+	NotificationTemplate NotificationTemplate `yaml:"notificationTemplate"`
+}
+
+// NotificationTemplate holds Go templates rendered with the decoded payload. Sprig functions are available.
+type NotificationTemplate struct {
+	Header      string      `yaml:"header"`
+	Description string      `yaml:"description"`
+	Fields      []FieldTpl  `yaml:"fields"`
+	Buttons     []ButtonTpl `yaml:"buttons"`
+}
+
+// FieldTpl represents a single text field.
+type FieldTpl struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value"`
+}
+
+// ButtonTpl represents a button. Either URL or CommandTpl must be specified.
+type ButtonTpl struct {
+	// DisplayName for the button.
+	DisplayName string `yaml:"displayName"`
+	// CommandTpl template for the Botkube command executed on click.
+	CommandTpl string `yaml:"commandTpl"`
+	// URL to open. If specified CommandTpl is ignored.
+	URL string `yaml:"url"`
+	// Style for button.
+	Style string `yaml:"style"`
+}
+
+// MergeConfigs merges all input configuration.
+func MergeConfigs(configs []*source.Config) (Config, error) {
+	defaults := Config{
+		Port: 2116,
+		Path: "/webhook",
+		Auth: Auth{
+			HMAC: HMAC{
+				Header: defaultSignatureHeader,
+			},
+		},
+		Log: config.Logger{
+			Level: "info",
+		},
+	}
+
+	var out Config
+	if err := pluginx.MergeSourceConfigsWithDefaults(defaults, configs, &out); err != nil {
+		return Config{}, fmt.Errorf("while merging configuration: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/jsonpathx"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const maxPayloadBytes = 1 << 20 // 1 MiB
+
+// Handler accepts JSON payloads and emits them as source events.
+type Handler struct {
+	log                      logrus.FieldLogger
+	cfg                      Config
+	isInteractivitySupported bool
+	matcher                  *jsonpathx.Matcher
+	ch                       chan<- source.Event
+}
+
+// NewHandler returns a new Handler instance.
+func NewHandler(log logrus.FieldLogger, cfg Config, isInteractivitySupported bool, ch chan<- source.Event) *Handler {
+	return &Handler{
+		log:                      log,
+		cfg:                      cfg,
+		isInteractivitySupported: isInteractivitySupported,
+		matcher:                  jsonpathx.NewMatcher(log),
+		ch:                       ch,
+	}
+}
+
+// ServeHTTP handles incoming webhook requests.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST method is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	raw, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("while reading payload: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.authenticate(r, raw); err != nil {
+		h.log.WithError(err).Warn("Rejecting unauthenticated webhook request")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var payload any
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		http.Error(w, fmt.Sprintf("while decoding payload: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	events, err := h.eventsFrom(raw, payload)
+	if err != nil {
+		h.log.WithError(err).Error("Cannot render webhook notification")
+		http.Error(w, "cannot render notification", http.StatusUnprocessableEntity)
+		return
+	}
+
+	for _, event := range events {
+		select {
+		case h.ch <- event:
+		case <-r.Context().Done():
+			http.Error(w, "request canceled", http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) eventsFrom(raw json.RawMessage, payload any) ([]source.Event, error) {
+	if len(h.cfg.Rules) == 0 {
+		msg, err := defaultMessage(payload)
+		if err != nil {
+			return nil, err
+		}
+		return []source.Event{{Message: msg, RawObject: payload}}, nil
+	}
+
+	var out []source.Event
+	for _, rule := range h.cfg.Rules {
+		if !h.matcher.IsEventMatchingCriteria(raw, rule.JSONPath, rule.Value) {
+			continue
+		}
+
+		msg, err := rule.NotificationTemplate.Render(payload, h.isInteractivitySupported)
+		if err != nil {
+			return nil, fmt.Errorf("while rendering message for rule %q: %w", rule.Name, err)
+		}
+		h.log.WithField("rule", rule.Name).Debug("Payload matched")
+		out = append(out, source.Event{Message: msg, RawObject: payload})
+	}
+	return out, nil
+}
+
+// authenticate checks all configured authentication methods.
+func (h *Handler) authenticate(r *http.Request, body []byte) error {
+	if token := h.cfg.Auth.BearerToken; token != "" {
+		got := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			return errors.New("invalid bearer token")
+		}
+	}
+
+	if secret := h.cfg.Auth.HMAC.Secret; secret != "" {
+		sig := strings.TrimPrefix(r.Header.Get(h.cfg.Auth.HMAC.Header), "sha256=")
+		got, err := hex.DecodeString(sig)
+		if err != nil {
+			return fmt.Errorf("while decoding signature: %w", err)
+		}
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(body)
+		if !hmac.Equal(got, mac.Sum(nil)) {
+			return errors.New("invalid signature")
+		}
+	}
+
+	return nil
+}
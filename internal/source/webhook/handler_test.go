@@ -0,0 +1,225 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const rolloutPayload = `{"rollout": "checkout", "namespace": "shop", "status": "Degraded", "revision": 4}`
+
+func TestHandlerRendersMatchingRules(t *testing.T) {
+	// given
+	cfg := Config{
+		Rules: []Rule{
+			{
+				Name:     "rollout-degraded",
+				JSONPath: "{.status}",
+				Value:    "Degraded",
+				NotificationTemplate: NotificationTemplate{
+					Header:      "Rollout {{ .rollout }} is {{ .status | lower }}",
+					Description: "Revision {{ .revision }}",
+					Fields: []FieldTpl{
+						{Key: "Namespace", Value: "{{ .namespace }}"},
+					},
+					Buttons: []ButtonTpl{
+						{DisplayName: "Abort", CommandTpl: "kubectl argo rollouts abort {{ .rollout }} -n {{ .namespace }}", Style: "danger"},
+						{DisplayName: "Dashboard", URL: "https://argo.example.com/rollouts/{{ .namespace }}/{{ .rollout }}"},
+					},
+				},
+			},
+			{
+				Name:     "rollout-healthy",
+				JSONPath: "{.status}",
+				Value:    "Healthy",
+			},
+		},
+	}
+	ch := make(chan source.Event, 2)
+	handler := NewHandler(loggerx.NewNoop(), cfg, true, ch)
+
+	// when
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(rolloutPayload)))
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, ch, 1)
+
+	event := <-ch
+	assert.Equal(t, "shop", event.RawObject.(map[string]any)["namespace"])
+
+	require.Len(t, event.Message.Sections, 1)
+	section := event.Message.Sections[0]
+	assert.Equal(t, "Rollout checkout is degraded", section.Header)
+	assert.Equal(t, "Revision 4", section.Description)
+	assert.Equal(t, api.TextFields{{Key: "Namespace", Value: "shop"}}, section.TextFields)
+	assert.Equal(t, api.Buttons{
+		{
+			Name:    "Abort",
+			Command: api.MessageBotNamePlaceholder + " kubectl argo rollouts abort checkout -n shop",
+			Style:   api.ButtonStyleDanger,
+		},
+		{
+			Name:  "Dashboard",
+			URL:   "https://argo.example.com/rollouts/shop/checkout",
+			Style: api.ButtonStyleDefault,
+		},
+	}, section.Buttons)
+}
+
+func TestHandlerSkipsButtonsOnNonInteractivePlatforms(t *testing.T) {
+	// given
+	cfg := Config{
+		Rules: []Rule{
+			{
+				Name: "all",
+				NotificationTemplate: NotificationTemplate{
+					Header:  "Rollout {{ .rollout }}",
+					Buttons: []ButtonTpl{{DisplayName: "Abort", CommandTpl: "kubectl argo rollouts abort {{ .rollout }}"}},
+				},
+			},
+		},
+	}
+	ch := make(chan source.Event, 1)
+	handler := NewHandler(loggerx.NewNoop(), cfg, false, ch)
+
+	// when
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(rolloutPayload)))
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, ch, 1)
+
+	event := <-ch
+	assert.Equal(t, api.NonInteractiveSingleSection, event.Message.Type)
+	assert.Equal(t, "Rollout checkout", event.Message.Sections[0].Header)
+	assert.Empty(t, event.Message.Sections[0].Buttons)
+}
+
+func TestHandlerEmitsAllPayloadsWithoutRules(t *testing.T) {
+	// given
+	ch := make(chan source.Event, 1)
+	handler := NewHandler(loggerx.NewNoop(), Config{}, true, ch)
+
+	// when
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{"status": "ok"}`)))
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, ch, 1)
+
+	event := <-ch
+	assert.Equal(t, "Incoming webhook", event.Message.Sections[0].Header)
+	assert.Equal(t, "{\n  \"status\": \"ok\"\n}", event.Message.Sections[0].Body.CodeBlock)
+}
+
+func TestHandlerAuthentication(t *testing.T) {
+	const secret = "s3cr3t"
+	cfg := Config{
+		Auth: Auth{
+			BearerToken: "token",
+			HMAC:        HMAC{Secret: secret, Header: defaultSignatureHeader},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		expCode int
+	}{
+		{
+			name: "Valid token and signature",
+			headers: map[string]string{
+				"Authorization":        "Bearer token",
+				defaultSignatureHeader: "sha256=" + sign(secret, rolloutPayload),
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "Invalid token",
+			headers: map[string]string{
+				"Authorization":        "Bearer other",
+				defaultSignatureHeader: sign(secret, rolloutPayload),
+			},
+			expCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Invalid signature",
+			headers: map[string]string{
+				"Authorization":        "Bearer token",
+				defaultSignatureHeader: sign("other", rolloutPayload),
+			},
+			expCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Missing signature",
+			headers: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			expCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ch := make(chan source.Event, 1)
+			handler := NewHandler(loggerx.NewNoop(), cfg, true, ch)
+			req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(rolloutPayload))
+			for key, val := range tc.headers {
+				req.Header.Set(key, val)
+			}
+			rec := httptest.NewRecorder()
+
+			// when
+			handler.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	// given
+	cfg := Config{
+		Path: "webhook",
+		Rules: []Rule{
+			{
+				Name: "broken",
+				NotificationTemplate: NotificationTemplate{
+					Header:  "{{ .rollout ",
+					Buttons: []ButtonTpl{{DisplayName: "Empty"}},
+				},
+			},
+		},
+	}
+
+	// when
+	err := validate(cfg)
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `path "webhook" must start with '/'`)
+	assert.Contains(t, err.Error(), `button "Empty" in rule "broken" must define either URL or command`)
+	assert.Contains(t, err.Error(), `invalid notification template in rule "broken"`)
+}
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
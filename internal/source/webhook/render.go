@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+// Render renders the api.Message for a given payload. Buttons are skipped on platforms without interactivity support.
+func (t NotificationTemplate) Render(payload any, isInteractivitySupported bool) (api.Message, error) {
+	header, err := renderGoTpl(t.Header, payload)
+	if err != nil {
+		return api.Message{}, fmt.Errorf("while rendering header: %w", err)
+	}
+	desc, err := renderGoTpl(t.Description, payload)
+	if err != nil {
+		return api.Message{}, fmt.Errorf("while rendering description: %w", err)
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header:      header,
+			Description: desc,
+		},
+	}
+
+	for _, field := range t.Fields {
+		value, err := renderGoTpl(field.Value, payload)
+		if err != nil {
+			return api.Message{}, fmt.Errorf("while rendering %q field: %w", field.Key, err)
+		}
+		section.TextFields = append(section.TextFields, api.TextField{Key: field.Key, Value: value})
+	}
+
+	if !isInteractivitySupported {
+		return api.Message{
+			Type:      api.NonInteractiveSingleSection,
+			Timestamp: time.Now(),
+			Sections:  []api.Section{section},
+		}, nil
+	}
+
+	for _, btn := range t.Buttons {
+		button, err := renderButton(btn, payload)
+		if err != nil {
+			return api.Message{}, fmt.Errorf("while rendering %q button: %w", btn.DisplayName, err)
+		}
+		section.Buttons = append(section.Buttons, button)
+	}
+
+	return api.Message{
+		Timestamp: time.Now(),
+		Sections:  []api.Section{section},
+	}, nil
+}
+
+func defaultMessage(payload any) (api.Message, error) {
+	out, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return api.Message{}, fmt.Errorf("while marshaling payload: %w", err)
+	}
+
+	return api.Message{
+		Timestamp: time.Now(),
+		Sections: []api.Section{
+			{
+				Base: api.Base{
+					Header: "Incoming webhook",
+					Body: api.Body{
+						CodeBlock: string(out),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func renderButton(tpl ButtonTpl, payload any) (api.Button, error) {
+	btns := api.NewMessageButtonBuilder()
+
+	if tpl.URL != "" {
+		url, err := renderGoTpl(tpl.URL, payload)
+		if err != nil {
+			return api.Button{}, err
+		}
+		return btns.ForURL(tpl.DisplayName, url, api.ButtonStyle(tpl.Style)), nil
+	}
+
+	cmd, err := renderGoTpl(tpl.CommandTpl, payload)
+	if err != nil {
+		return api.Button{}, err
+	}
+	return btns.ForCommandWithoutDesc(tpl.DisplayName, cmd, api.ButtonStyle(tpl.Style)), nil
+}
+
+func renderGoTpl(tpl string, data any) (string, error) {
+	if tpl == "" {
+		return "", nil
+	}
+
+	tmpl, err := template.New("tpl").Funcs(sprig.FuncMap()).Parse(tpl)
+	if err != nil {
+		return "", err
+	}
+
+	var buff bytes.Buffer
+	if err := tmpl.Execute(&buff, data); err != nil {
+		return "", err
+	}
+	return buff.String(), nil
+}
@@ -0,0 +1,292 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/MakeNowJust/heredoc"
+	"gopkg.in/yaml.v3"
+
+	"github.com/kubeshop/botkube/internal/httpx"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+const (
+	// PluginName is the name of the incoming webhook Botkube plugin.
+	PluginName = "webhook"
+
+	description = "Emits events for JSON payloads sent to an HTTP endpoint. Use it to get notifications from systems without a dedicated source."
+)
+
+// Source incoming webhook source plugin data structure
+type Source struct {
+	pluginVersion string
+	servers       *httpx.ServerPool
+}
+
+// NewSource returns a new instance of Source.
+func NewSource(version string) *Source {
+	return &Source{
+		pluginVersion: version,
+		servers:       httpx.NewServerPool(),
+	}
+}
+
+// Stream registers the payload handler and emits events for received payloads.
+// All streams run in a single plugin process, so sources configured with the same port share a single HTTP server.
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+	cfg, err := MergeConfigs(input.Configs)
+	if err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
+	}
+
+	if err := validate(cfg); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	log := loggerx.New(cfg.Log)
+	out := source.StreamOutput{Event: make(chan source.Event)}
+
+	owner, err := configOwner(cfg)
+	if err != nil {
+		return source.StreamOutput{}, err
+	}
+
+	handler := NewHandler(log, cfg, input.Context.IsInteractivitySupported, out.Event)
+	if err := s.servers.Handle(ctx, log, cfg.Port, cfg.Path, owner, handler); err != nil {
+		return source.StreamOutput{}, fmt.Errorf("while starting webhook server: %w", err)
+	}
+
+	return out, nil
+}
+
+// Metadata returns metadata of incoming webhook configuration
+func (s *Source) Metadata(_ context.Context) (api.MetadataOutput, error) {
+	return api.MetadataOutput{
+		Version:     s.pluginVersion,
+		Description: description,
+		JSONSchema:  jsonSchema(),
+	}, nil
+}
+
+func validate(cfg Config) error {
+	issues := multierror.New()
+	if !strings.HasPrefix(cfg.Path, "/") {
+		issues = multierror.Append(issues, fmt.Errorf("path %q must start with '/'", cfg.Path))
+	}
+	if cfg.Auth.HMAC.Secret != "" && cfg.Auth.HMAC.Header == "" {
+		issues = multierror.Append(issues, errors.New("HMAC signature header cannot be empty"))
+	}
+
+	for _, rule := range cfg.Rules {
+		tpl := rule.NotificationTemplate
+		for _, btn := range tpl.Buttons {
+			if btn.URL == "" && btn.CommandTpl == "" {
+				issues = multierror.Append(issues, fmt.Errorf("button %q in rule %q must define either URL or command", btn.DisplayName, rule.Name))
+			}
+		}
+
+		// check the templates syntax upfront to fail fast on start
+		_, err := tpl.Render(map[string]any{}, true)
+		var execErr template.ExecError
+		if err != nil && !errors.As(err, &execErr) {
+			issues = multierror.Append(issues, fmt.Errorf("invalid notification template in rule %q: %w", rule.Name, err))
+		}
+	}
+
+	return issues.ErrorOrNil()
+}
+
+// configOwner returns the identifier of a given configuration. The same source is streamed separately for interactive
+// and non-interactive platforms, so such streams can share the path, while other sources must use a different one.
+func configOwner(cfg Config) (string, error) {
+	cfg.Log = config.Logger{}
+	raw, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("while marshaling configuration: %w", err)
+	}
+	return string(raw), nil
+}
+
+func jsonSchema() api.JSONSchema {
+	return api.JSONSchema{
+		Value: heredoc.Docf(`{
+		  "$schema": "http://json-schema.org/draft-07/schema#",
+		  "title": "Incoming webhook",
+		  "description": "%s",
+		  "type": "object",
+		  "properties": {
+			"port": {
+			  "title": "Port",
+			  "description": "Port on which the webhook server listens. Sources with the same port share the server, so they must use different paths.",
+			  "type": "integer",
+			  "default": 2116
+			},
+			"path": {
+			  "title": "Path",
+			  "description": "Path on which the payloads are accepted.",
+			  "type": "string",
+			  "default": "/webhook"
+			},
+			"auth": {
+			  "title": "Authentication",
+			  "description": "Optional request authentication. All configured methods must pass.",
+			  "type": "object",
+			  "properties": {
+				"bearerToken": {
+				  "title": "Bearer token",
+				  "description": "Token expected in the 'Authorization: Bearer <token>' header.",
+				  "type": "string"
+				},
+				"hmac": {
+				  "title": "HMAC signature",
+				  "type": "object",
+				  "properties": {
+					"secret": {
+					  "title": "Secret",
+					  "description": "Secret used to compute the HMAC-SHA256 signature of the request body.",
+					  "type": "string"
+					},
+					"header": {
+					  "title": "Header",
+					  "description": "Header with the hex-encoded signature. The optional 'sha256=' prefix is trimmed.",
+					  "type": "string",
+					  "default": "X-Signature-256"
+					}
+				  }
+				}
+			  }
+			},
+			"rules": {
+			  "title": "Rules",
+			  "description": "Define which payloads are emitted and how they are rendered. If not specified, all payloads are emitted.",
+			  "type": "array",
+			  "items": {
+				"type": "object",
+				"properties": {
+				  "name": {
+					"title": "Name",
+					"type": "string"
+				  },
+				  "jsonPath": {
+					"title": "JSONPath",
+					"description": "The JSONPath expression to filter payloads, e.g. '{.status}'.",
+					"type": "string"
+				  },
+				  "value": {
+					"title": "Value",
+					"description": "The value to match in the JSONPath result.",
+					"type": "string"
+				  },
+				  "notificationTemplate": {
+					"title": "Notification template",
+					"description": "Go templates rendered with the decoded payload. Sprig functions are available.",
+					"type": "object",
+					"properties": {
+					  "header": {
+						"type": "string"
+					  },
+					  "description": {
+						"type": "string"
+					  },
+					  "fields": {
+						"type": "array",
+						"items": {
+						  "type": "object",
+						  "properties": {
+							"key": {
+							  "type": "string"
+							},
+							"value": {
+							  "type": "string"
+							}
+						  }
+						}
+					  },
+					  "buttons": {
+						"type": "array",
+						"items": {
+						  "type": "object",
+						  "properties": {
+							"displayName": {
+							  "type": "string"
+							},
+							"commandTpl": {
+							  "type": "string"
+							},
+							"url": {
+							  "type": "string"
+							},
+							"style": {
+							  "type": "string",
+							  "enum": ["", "primary", "danger"]
+							}
+						  }
+						}
+					  }
+					}
+				  }
+				},
+				"required": ["name"]
+			  }
+			},
+			"log": {
+			  "title": "Logging",
+			  "description": "Logging configuration for the plugin.",
+			  "type": "object",
+			  "properties": {
+				"level": {
+				  "title": "Log Level",
+				  "description": "Define log level for the plugin. Ensure that Botkube has plugin logging enabled for standard output.",
+				  "type": "string",
+				  "default": "info",
+				  "oneOf": [
+					{
+					  "const": "panic",
+					  "title": "Panic"
+					},
+					{
+					  "const": "fatal",
+					  "title": "Fatal"
+					},
+					{
+					  "const": "error",
+					  "title": "Error"
+					},
+					{
+					  "const": "warn",
+					  "title": "Warning"
+					},
+					{
+					  "const": "info",
+					  "title": "Info"
+					},
+					{
+					  "const": "debug",
+					  "title": "Debug"
+					},
+					{
+					  "const": "trace",
+					  "title": "Trace"
+					}
+				  ]
+				},
+				"disableColors": {
+				  "type": "boolean",
+				  "default": false,
+				  "description": "If enabled, disables color logging output.",
+				  "title": "Disable Colors"
+				}
+			  }
+			}
+		  }
+		}`, description),
+	}
+}
@@ -0,0 +1,349 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+const (
+	notificationsKey = "notifications"
+
+	// maxRememberedMessages limits the number of sent messages kept in memory to be edited on acknowledge.
+	maxRememberedMessages = 500
+	// messageIDLength is the length of IDs of remembered messages, passed in acknowledge buttons.
+	messageIDLength = 8
+
+	// acknowledgementTTL defines how long acknowledgements are kept in the system ConfigMap.
+	acknowledgementTTL = 7 * 24 * time.Hour
+	// maxAcknowledgements limits the number of acknowledgements kept in the system ConfigMap, so it doesn't exceed the size limit.
+	maxAcknowledgements = 1000
+)
+
+// Silence describes suppressed notifications for a given key.
+type Silence struct {
+	Key       string    `json:"key"`
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+	// ExpiresAt is empty for persistent silence rules.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// IsActive returns true if the silence hasn't expired yet.
+func (s Silence) IsActive(now time.Time) bool {
+	return s.ExpiresAt == nil || now.Before(*s.ExpiresAt)
+}
+
+// Acknowledgement describes who acknowledged notifications for a given key.
+type Acknowledgement struct {
+	By string    `json:"by"`
+	At time.Time `json:"at"`
+}
+
+// NotificationEntries defines the notification actions persistence model.
+type NotificationEntries struct {
+	Silences         map[string]Silence         `json:"silences,omitempty"`
+	Acknowledgements map[string]Acknowledgement `json:"acknowledgements,omitempty"`
+}
+
+// Notifications provides functionality to persist acknowledged, snoozed and silenced notifications.
+// The state is cached in memory, so it can be checked for each dispatched event.
+type Notifications struct {
+	systemConfigMapName      string
+	systemConfigMapNamespace string
+	k8sCli                   kubernetes.Interface
+	now                      func() time.Time
+
+	mu      sync.RWMutex
+	entries NotificationEntries
+	// messages holds remembered messages by their IDs, and messageIDs holds the IDs in the order they were remembered.
+	messages   map[string]api.Message
+	messageIDs []string
+}
+
+// NewForNotifications returns a new Notifications instance.
+func NewForNotifications(ns, name string, k8sCli kubernetes.Interface) *Notifications {
+	return &Notifications{
+		systemConfigMapNamespace: ns,
+		systemConfigMapName:      name,
+		k8sCli:                   k8sCli,
+		now:                      time.Now,
+		entries:                  newNotificationEntries(),
+		messages:                 map[string]api.Message{},
+	}
+}
+
+// Load loads the persisted state from the system ConfigMap.
+func (n *Notifications) Load(ctx context.Context) error {
+	cm, err := n.k8sCli.CoreV1().ConfigMaps(n.systemConfigMapNamespace).Get(ctx, n.systemConfigMapName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		return nil
+	default:
+		return fmt.Errorf("while getting the Config Map: %w", err)
+	}
+
+	entries, err := n.extractNotificationEntries(cm)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.entries = entries
+	return nil
+}
+
+// IsSuppressed returns true if notifications for a given key are snoozed or silenced.
+func (n *Notifications) IsSuppressed(key string) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	silence, found := n.entries.Silences[key]
+	return found && silence.IsActive(n.now())
+}
+
+// Silences returns all active silences sorted by key.
+func (n *Notifications) Silences() []Silence {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	now := n.now()
+	var out []Silence
+	for _, silence := range n.entries.Silences {
+		if silence.IsActive(now) {
+			out = append(out, silence)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
+
+// Snooze suppresses notifications for a given key for a given duration.
+func (n *Notifications) Snooze(ctx context.Context, key, by string, duration time.Duration) (Silence, error) {
+	now := n.now()
+	expiresAt := now.Add(duration)
+	silence := Silence{Key: key, CreatedBy: by, CreatedAt: now, ExpiresAt: &expiresAt}
+	return silence, n.update(ctx, func(entries *NotificationEntries) {
+		entries.Silences[key] = silence
+	})
+}
+
+// Silence suppresses notifications for a given key until the silence is removed.
+func (n *Notifications) Silence(ctx context.Context, key, by string) (Silence, error) {
+	silence := Silence{Key: key, CreatedBy: by, CreatedAt: n.now()}
+	return silence, n.update(ctx, func(entries *NotificationEntries) {
+		entries.Silences[key] = silence
+	})
+}
+
+// Unsilence removes a snooze or silence for a given key. It returns false if there was no such silence.
+func (n *Notifications) Unsilence(ctx context.Context, key string) (bool, error) {
+	found := false
+	err := n.update(ctx, func(entries *NotificationEntries) {
+		_, found = entries.Silences[key]
+		delete(entries.Silences, key)
+	})
+	return found, err
+}
+
+// Acknowledge records who acknowledged notifications for a given key.
+func (n *Notifications) Acknowledge(ctx context.Context, key, by string) (Acknowledgement, error) {
+	ack := Acknowledgement{By: by, At: n.now()}
+	return ack, n.update(ctx, func(entries *NotificationEntries) {
+		entries.Acknowledgements[key] = ack
+	})
+}
+
+// RememberMessage keeps a sent message in memory, so it can be edited once acknowledged. It returns a copy of the message
+// with acknowledge buttons pointing to the remembered message, so acknowledging an older message doesn't replace it with a newer one.
+func (n *Notifications) RememberMessage(key string, msg api.Message) api.Message {
+	// bots replace placeholders in place, so we need our own copy
+	stamped, err := copyMessage(msg)
+	if err != nil {
+		return msg
+	}
+
+	id := uuid.NewString()[:messageIDLength]
+	ackCmd := fmt.Sprintf("%s %s", api.MessageBotNamePlaceholder, api.NotificationActionCommand(api.NotificationAckVerb, key))
+	for _, section := range stamped.Sections {
+		for idx, btn := range section.Buttons {
+			if btn.Command == ackCmd {
+				section.Buttons[idx].Command = fmt.Sprintf("%s %s", ackCmd, id)
+			}
+		}
+	}
+
+	remembered, err := copyMessage(stamped)
+	if err != nil {
+		return msg
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.messages[id] = remembered
+	n.messageIDs = append(n.messageIDs, id)
+	for len(n.messageIDs) > maxRememberedMessages {
+		delete(n.messages, n.messageIDs[0])
+		n.messageIDs = n.messageIDs[1:]
+	}
+
+	return stamped
+}
+
+// Message returns a remembered message with a given ID.
+func (n *Notifications) Message(id string) (api.Message, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	msg, found := n.messages[id]
+	if !found {
+		return api.Message{}, false
+	}
+
+	out, err := copyMessage(msg)
+	if err != nil {
+		return api.Message{}, false
+	}
+	return out, true
+}
+
+func copyMessage(in api.Message) (api.Message, error) {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return api.Message{}, err
+	}
+	var out api.Message
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return api.Message{}, err
+	}
+	return out, nil
+}
+
+func (n *Notifications) update(ctx context.Context, mutate func(entries *NotificationEntries)) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	cmClient := n.k8sCli.CoreV1().ConfigMaps(n.systemConfigMapNamespace)
+	old, err := cmClient.Get(ctx, n.systemConfigMapName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+	case apierrors.IsNotFound(err):
+		old = nil
+	default:
+		return fmt.Errorf("while getting the Config Map: %w", err)
+	}
+
+	entries := newNotificationEntries()
+	if old != nil {
+		entries, err = n.extractNotificationEntries(old)
+		if err != nil {
+			return err
+		}
+	}
+
+	mutate(&entries)
+	n.removeExpired(&entries)
+
+	raw, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("while marshaling notification entries: %w", err)
+	}
+
+	if old == nil {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      n.systemConfigMapName,
+				Namespace: n.systemConfigMapNamespace,
+			},
+			Data: map[string]string{
+				notificationsKey: string(raw),
+			},
+		}
+		if _, err := cmClient.Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("while creating the ConfigMap with notification details: %w", err)
+		}
+		n.entries = entries
+		return nil
+	}
+
+	newCM := old.DeepCopy()
+	if newCM.Data == nil {
+		newCM.Data = map[string]string{}
+	}
+	newCM.Data[notificationsKey] = string(raw)
+	if _, err := cmClient.Update(ctx, newCM, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("while updating the ConfigMap with notification details: %w", err)
+	}
+	n.entries = entries
+	return nil
+}
+
+func (n *Notifications) removeExpired(entries *NotificationEntries) {
+	now := n.now()
+	for key, silence := range entries.Silences {
+		if !silence.IsActive(now) {
+			delete(entries.Silences, key)
+		}
+	}
+
+	var ackKeys []string
+	for key, ack := range entries.Acknowledgements {
+		if now.Sub(ack.At) > acknowledgementTTL {
+			delete(entries.Acknowledgements, key)
+			continue
+		}
+		ackKeys = append(ackKeys, key)
+	}
+
+	if len(ackKeys) <= maxAcknowledgements {
+		return
+	}
+	sort.Slice(ackKeys, func(i, j int) bool {
+		return entries.Acknowledgements[ackKeys[i]].At.After(entries.Acknowledgements[ackKeys[j]].At)
+	})
+	for _, key := range ackKeys[maxAcknowledgements:] {
+		delete(entries.Acknowledgements, key)
+	}
+}
+
+func (n *Notifications) extractNotificationEntries(cm *corev1.ConfigMap) (NotificationEntries, error) {
+	out := newNotificationEntries()
+	data, found := cm.Data[notificationsKey]
+	if !found {
+		return out, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &out); err != nil {
+		return NotificationEntries{}, fmt.Errorf("while unmarshaling the notification data: %w", err)
+	}
+	if out.Silences == nil {
+		out.Silences = map[string]Silence{}
+	}
+	if out.Acknowledgements == nil {
+		out.Acknowledgements = map[string]Acknowledgement{}
+	}
+	return out, nil
+}
+
+func newNotificationEntries() NotificationEntries {
+	return NotificationEntries{
+		Silences:         map[string]Silence{},
+		Acknowledgements: map[string]Acknowledgement{},
+	}
+}
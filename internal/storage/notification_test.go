@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestNotificationsRemovesOldAcknowledgements(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	store := NewForNotifications("botkube", "botkube-system", fake.NewSimpleClientset())
+	store.now = func() time.Time { return now }
+
+	_, err := store.Acknowledge(ctx, "kubernetes/Pod/default/old", "alice")
+	require.NoError(t, err)
+
+	// when
+	now = now.Add(acknowledgementTTL + time.Minute)
+	_, err = store.Acknowledge(ctx, "kubernetes/Pod/default/new", "bob")
+	require.NoError(t, err)
+
+	// then
+	assert.Len(t, store.entries.Acknowledgements, 1)
+	assert.Contains(t, store.entries.Acknowledgements, "kubernetes/Pod/default/new")
+}
+
+func TestNotificationsLimitsAcknowledgements(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	store := NewForNotifications("botkube", "botkube-system", fake.NewSimpleClientset())
+	store.now = func() time.Time { return now }
+
+	// when
+	for i := 0; i < maxAcknowledgements+5; i++ {
+		now = now.Add(time.Second)
+		_, err := store.Acknowledge(ctx, fmt.Sprintf("kubernetes/Pod/default/nginx-%d", i), "alice")
+		require.NoError(t, err)
+	}
+
+	// then
+	assert.Len(t, store.entries.Acknowledgements, maxAcknowledgements)
+	assert.NotContains(t, store.entries.Acknowledgements, "kubernetes/Pod/default/nginx-4")
+	assert.Contains(t, store.entries.Acknowledgements, "kubernetes/Pod/default/nginx-5")
+}
+
+func TestNotificationsRemembersEachMessage(t *testing.T) {
+	// given
+	store := NewForNotifications("botkube", "botkube-system", fake.NewSimpleClientset())
+	key := "kubernetes/Pod/default/nginx"
+	fixMessage := func(header string) api.Message {
+		return api.Message{
+			Sections: []api.Section{
+				{Base: api.Base{Header: header}},
+				api.NotificationActionsSection(key),
+			},
+		}
+	}
+
+	// when
+	first := store.RememberMessage(key, fixMessage("Pod failed"))
+	second := store.RememberMessage(key, fixMessage("Pod failed again"))
+
+	// then
+	firstAckCmd := first.Sections[1].Buttons[0].Command
+	secondAckCmd := second.Sections[1].Buttons[0].Command
+	assert.NotEqual(t, firstAckCmd, secondAckCmd)
+
+	for _, sent := range []api.Message{first, second} {
+		ackCmd := sent.Sections[1].Buttons[0].Command
+		msgID := ackCmd[strings.LastIndex(ackCmd, " ")+1:]
+		remembered, found := store.Message(msgID)
+		require.True(t, found)
+		assert.Equal(t, sent, remembered)
+	}
+}
@@ -0,0 +1,43 @@
+package syncx
+
+import "sync"
+
+// KeyedMutex is a set of mutexes identified by keys. Operations for different keys don't block each other.
+// Mutexes are removed once they are no longer used, so the number of keys doesn't grow unbounded.
+type KeyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	mu sync.Mutex
+	// waiters is the number of goroutines holding or waiting for the lock.
+	waiters int
+}
+
+// Lock locks the mutex for a given key and returns a function which unlocks it.
+func (m *KeyedMutex) Lock(key string) (unlock func()) {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*keyLock{}
+	}
+	lock, found := m.locks[key]
+	if !found {
+		lock = &keyLock{}
+		m.locks[key] = lock
+	}
+	lock.waiters++
+	m.mu.Unlock()
+
+	lock.mu.Lock()
+	return func() {
+		lock.mu.Unlock()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		lock.waiters--
+		if lock.waiters == 0 {
+			delete(m.locks, key)
+		}
+	}
+}
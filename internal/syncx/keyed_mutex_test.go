@@ -0,0 +1,55 @@
+package syncx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	// given
+	var m KeyedMutex
+	unlockFirst := m.Lock("first")
+
+	// when
+	otherLocked := make(chan struct{})
+	go func() {
+		unlock := m.Lock("other")
+		defer unlock()
+		close(otherLocked)
+	}()
+
+	sameLocked := make(chan struct{})
+	go func() {
+		unlock := m.Lock("first")
+		defer unlock()
+		close(sameLocked)
+	}()
+
+	// then
+	select {
+	case <-otherLocked:
+	case <-time.After(time.Second):
+		t.Fatal("lock for a different key was blocked")
+	}
+
+	select {
+	case <-sameLocked:
+		t.Fatal("lock for the same key was acquired twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlockFirst()
+	select {
+	case <-sameLocked:
+	case <-time.After(time.Second):
+		t.Fatal("lock for the same key was not released")
+	}
+
+	assert.Eventually(t, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return len(m.locks) == 0
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"fmt"
+	"time"
+)
+
+// Notification action verbs and feature handled by the built-in notification executor.
+const (
+	NotificationFeatureName   = "notification"
+	NotificationAckVerb       = "ack"
+	NotificationSnoozeVerb    = "snooze"
+	NotificationSilenceVerb   = "silence"
+	NotificationUnsilenceVerb = "unsilence"
+)
+
+// NotificationSnoozeDurations holds the snooze durations offered on notifications.
+var NotificationSnoozeDurations = []time.Duration{time.Hour, 24 * time.Hour}
+
+// NotificationActionsSection returns a section with buttons to acknowledge, snooze and silence notifications for a given key.
+// The key identifies the object the notification relates to, for example a given Kubernetes resource or Prometheus alert.
+func NotificationActionsSection(key string) Section {
+	btns := NewMessageButtonBuilder()
+	buttons := Buttons{
+		btns.ForCommandWithoutDesc("Acknowledge", NotificationActionCommand(NotificationAckVerb, key), ButtonStylePrimary),
+	}
+	for _, d := range NotificationSnoozeDurations {
+		buttons = append(buttons, btns.ForCommandWithoutDesc(fmt.Sprintf("Snooze %s", FormatSnoozeDuration(d)), NotificationActionCommand(NotificationSnoozeVerb, key, FormatSnoozeDuration(d))))
+	}
+	buttons = append(buttons, btns.ForCommandWithoutDesc("Silence rule", NotificationActionCommand(NotificationSilenceVerb, key), ButtonStyleDanger))
+
+	return Section{
+		Buttons: buttons,
+	}
+}
+
+// NotificationActionCommand returns the command for a given notification action, e.g. `snooze notification kubernetes/Pod/default/nginx 1h`.
+func NotificationActionCommand(verb, key string, args ...string) string {
+	cmd := fmt.Sprintf("%s %s %s", verb, NotificationFeatureName, key)
+	for _, arg := range args {
+		cmd = fmt.Sprintf("%s %s", cmd, arg)
+	}
+	return cmd
+}
+
+// FormatSnoozeDuration returns a short representation of a given duration, e.g. `1h` or `24h`.
+func FormatSnoozeDuration(d time.Duration) string {
+	if d%time.Hour == 0 {
+		return fmt.Sprintf("%dh", d/time.Hour)
+	}
+	if d%time.Minute == 0 {
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+	return d.String()
+}
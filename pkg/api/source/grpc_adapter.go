@@ -55,6 +55,16 @@ type (
 		Message         api.Message
 		RawObject       any
 		AnalyticsLabels map[string]interface{}
+		// CorrelationKey groups related events, e.g. all events for a given Deployment and the objects it owns.
+		// Communication platforms which support threads post follow-up events with the same key as replies
+		// to the first message. Empty key means that the event is not correlated with any other event.
+		CorrelationKey string
+		// NotificationKey identifies the object the event relates to, e.g. a given Kubernetes resource or Prometheus alert.
+		// It's used by the acknowledge, snooze and silence notification actions. Empty key means that the event cannot be suppressed.
+		NotificationKey string
+		// LivingMessageKey identifies a message which is edited in place on follow-up events with the same key,
+		// on communication platforms which support editing messages. Empty key means that a new message is posted for each event.
+		LivingMessageKey string
 	}
 )
 
@@ -20,6 +20,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -27,7 +28,11 @@ import (
 //    - split to multiple files in a separate package,
 //    - review all the methods and see if they can be simplified.
 
-var _ Bot = &Discord{}
+var (
+	_ Bot                  = &Discord{}
+	_ notifier.ThreadedBot = &Discord{}
+	_ notifier.EditableBot = &Discord{}
+)
 
 const (
 	// discordBotMentionRegexFmt supports also nicknames (the exclamation mark).
@@ -36,6 +41,11 @@ const (
 
 	// discordMaxMessageSize max size before a message should be uploaded as a file.
 	discordMaxMessageSize = 2000
+
+	// discordThreadName is the name of threads started for correlated messages.
+	discordThreadName = "Follow-up events"
+	// discordThreadArchiveDuration is the duration in minutes after which inactive threads are archived.
+	discordThreadArchiveDuration = 1440
 )
 
 // Discord listens for user's message, execute commands and sends back the response.
@@ -52,6 +62,7 @@ type Discord struct {
 	commGroupName         string
 	renderer              *DiscordRenderer
 	messages              chan discordMessage
+	msgRefs               *MessageRefStore
 	discordMessageWorkers *pool.Pool
 	shutdownOnce          sync.Once
 }
@@ -89,6 +100,7 @@ func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord
 		botMentionRegex:       botMentionRegex,
 		renderer:              NewDiscordRenderer(),
 		messages:              make(chan discordMessage, platformMessageChannelSize),
+		msgRefs:               NewMessageRefStore(log),
 		discordMessageWorkers: pool.New().WithMaxGoroutines(platformMessageWorkersCount),
 	}, nil
 }
@@ -137,17 +149,44 @@ func (b *Discord) Start(ctx context.Context) error {
 
 // SendMessage sends interactive message to selected Discord channels.
 // Context is not supported by client: See https://github.com/bwmarrin/discordgo/issues/752.
-func (b *Discord) SendMessage(_ context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *Discord) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string) ([]notifier.MessageRef, error) {
+	var refs []notifier.MessageRef
+	var failed []string
 	errs := multierror.New()
-	for _, channelID := range b.getChannelsToNotify(sourceBindings) {
-		err := b.send(channelID, msg)
+	for _, channelID := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
+		ref, err := b.postMessage(ctx, channelID, msg)
 		if err != nil {
+			failed = append(failed, channelID)
 			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
 			continue
 		}
+		ref.Channel = channelID
+		refs = append(refs, ref)
 	}
 
-	return errs.ErrorOrNil()
+	return refs, notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
+}
+
+// EditMessage replaces the content of a given Discord message.
+func (b *Discord) EditMessage(ctx context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	return b.editMessage(ctx, ref, msg)
+}
+
+// SendCorrelatedMessage sends interactive message to selected Discord channels. Messages with the same correlation key are posted in a single thread.
+// Context is not supported by client: See https://github.com/bwmarrin/discordgo/issues/752.
+func (b *Discord) SendCorrelatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string, correlationKey string) error {
+	var failed []string
+	errs := multierror.New()
+	for _, channelID := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
+		err := b.msgRefs.Send(ctx, b, channelID, correlationKey, msg)
+		if err != nil {
+			failed = append(failed, channelID)
+			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
+			continue
+		}
+	}
+
+	return notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
 }
 
 // SendMessageToAll sends interactive message to all Discord channels.
@@ -178,6 +217,11 @@ func (b *Discord) Type() config.IntegrationType {
 }
 
 // TODO: Support custom routing via annotations for Discord as well
+// ChannelsToNotify returns IDs of Discord channels bound to given sources.
+func (b *Discord) ChannelsToNotify(sourceBindings []string) []string {
+	return b.getChannelsToNotify(sourceBindings)
+}
+
 func (b *Discord) getChannelsToNotify(sourceBindings []string) []string {
 	var out []string
 	for _, cfg := range b.getChannels() {
@@ -259,6 +303,9 @@ func (b *Discord) handleMessage(ctx context.Context, dm discordMessage) error {
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", dm.Event.Author.ID),
 			DisplayName: dm.Event.Author.String(),
+			ID:          dm.Event.Author.ID,
+			Email:       dm.Event.Author.Email,
+			Groups:      memberRoles(dm.Event.Member),
 		},
 	})
 
@@ -272,22 +319,69 @@ func (b *Discord) handleMessage(ctx context.Context, dm discordMessage) error {
 }
 
 func (b *Discord) send(channelID string, resp interactive.CoreMessage) error {
-	b.log.Debugf("Sending message to channel %q: %+v", channelID, resp)
+	_, err := b.sendComplex(channelID, resp)
+	return err
+}
+
+func (b *Discord) postMessage(_ context.Context, channelID string, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	sent, err := b.sendComplex(channelID, msg)
+	if err != nil {
+		return notifier.MessageRef{}, err
+	}
+	return notifier.MessageRef{ChannelID: channelID, MessageID: sent.ID}, nil
+}
 
-	resp.ReplaceBotNamePlaceholder(b.BotName())
+func (b *Discord) postReply(_ context.Context, parent notifier.MessageRef, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	if parent.ThreadID == "" {
+		thread, err := b.api.MessageThreadStart(parent.ChannelID, parent.MessageID, discordThreadName, discordThreadArchiveDuration)
+		if err != nil {
+			return parent, fmt.Errorf("while starting thread: %w", discordError(err, parent.ChannelID))
+		}
+		parent.ThreadID = thread.ID
+	}
 
-	discordMsg, err := b.formatMessage(resp)
+	_, err := b.sendComplex(parent.ThreadID, msg)
+	return parent, err
+}
+
+func (b *Discord) editMessage(_ context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	discordMsg, err := b.formatMessage(msg)
 	if err != nil {
 		return fmt.Errorf("while formatting message: %w", err)
 	}
-	if _, err := b.api.ChannelMessageSendComplex(channelID, discordMsg); err != nil {
-		return fmt.Errorf("while sending message: %w", discordError(err, channelID))
+	// Too long messages are uploaded as files, which cannot be edited in place.
+	if len(discordMsg.Files) > 0 {
+		return errors.New("message is too long to be edited")
 	}
 
-	b.log.Debugf("Message successfully sent to channel %q", channelID)
+	edit := discordgo.NewMessageEdit(ref.ChannelID, ref.MessageID)
+	edit.Content = &discordMsg.Content
+	edit.Embeds = discordMsg.Embeds
+	if _, err := b.api.ChannelMessageEditComplex(edit); err != nil {
+		return fmt.Errorf("while editing message: %w", discordError(err, ref.ChannelID))
+	}
 	return nil
 }
 
+// sendComplex sends a message to a given channel and returns the sent message.
+func (b *Discord) sendComplex(channelID string, msg interactive.CoreMessage) (*discordgo.Message, error) {
+	b.log.Debugf("Sending message to channel %q: %+v", channelID, msg)
+
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	discordMsg, err := b.formatMessage(msg)
+	if err != nil {
+		return nil, fmt.Errorf("while formatting message: %w", err)
+	}
+	sent, err := b.api.ChannelMessageSendComplex(channelID, discordMsg)
+	if err != nil {
+		return nil, fmt.Errorf("while sending message: %w", discordError(err, channelID))
+	}
+
+	b.log.Debugf("Message successfully sent to channel %q", channelID)
+	return sent, nil
+}
+
 // BotName returns the Bot name.
 func (b *Discord) BotName() string {
 	// Note: we can use the botID, but it's not rendered well.
@@ -415,3 +509,11 @@ func discordError(err error, channel string) error {
 	}
 	return err
 }
+
+// memberRoles returns role IDs of a guild member. Member details are not available for direct messages.
+func memberRoles(member *discordgo.Member) []string {
+	if member == nil {
+		return nil
+	}
+	return member.Roles
+}
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/sirupsen/logrus"
@@ -21,6 +22,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -28,7 +30,11 @@ import (
 //    - split to multiple files in a separate package,
 //    - review all the methods and see if they can be simplified.
 
-var _ Bot = &Mattermost{}
+var (
+	_ Bot                  = &Mattermost{}
+	_ notifier.ThreadedBot = &Mattermost{}
+	_ notifier.EditableBot = &Mattermost{}
+)
 
 const (
 	// WebSocketProtocol stores protocol initials for web socket
@@ -42,6 +48,9 @@ const (
 	mattermostBotMentionRegexFmt  = "^@(?i)%s"
 	mattermostMessageChannelSize  = 100
 	mattermostMessageWorkersCount = 10
+
+	// mattermostUserCacheTTL defines how long user details are cached. User roles are used for RBAC, so they must not be stale for too long.
+	mattermostUserCacheTTL = 5 * time.Minute
 )
 
 // TODO:
@@ -66,8 +75,10 @@ type Mattermost struct {
 	notifyMutex     sync.Mutex
 	botMentionRegex *regexp.Regexp
 	renderer        *MattermostRenderer
-	userNamesForID  map[string]string
+	usersMutex      sync.Mutex
+	usersForID      map[string]cachedMattermostUser
 	messages        chan mattermostMessage
+	msgRefs         *MessageRefStore
 	messageWorkers  *pool.Pool
 	shutdownOnce    sync.Once
 }
@@ -135,8 +146,9 @@ func NewMattermost(ctx context.Context, log logrus.FieldLogger, commGroupName st
 		channels:        channelsByIDCfg,
 		botMentionRegex: botMentionRegex,
 		renderer:        NewMattermostRenderer(),
-		userNamesForID:  map[string]string{},
+		usersForID:      map[string]cachedMattermostUser{},
 		messages:        make(chan mattermostMessage, platformMessageChannelSize),
+		msgRefs:         NewMessageRefStore(log),
 		messageWorkers:  pool.New().WithMaxGoroutines(platformMessageWorkersCount),
 	}, nil
 }
@@ -262,12 +274,21 @@ func (b *Mattermost) handleMessage(ctx context.Context, mm mattermostMessage) er
 		}
 	}
 
-	userName, err := b.getUserName(ctx, post.UserId)
+	user := execute.UserInput{
+		//Mention:     "", // not used currently
+		DisplayName: post.UserId,
+		ID:          post.UserId,
+	}
+	mmUser, err := b.getUser(ctx, post.UserId)
 	if err != nil {
-		b.log.Errorf("while getting user name: %s", err.Error())
+		b.log.Errorf("while getting user details: %s", err.Error())
 	}
-	if userName == "" {
-		userName = post.UserId
+	if mmUser != nil {
+		if mmUser.Username != "" {
+			user.DisplayName = mmUser.Username
+		}
+		user.Email = mmUser.Email
+		user.Groups = strings.Fields(mmUser.Roles)
 	}
 
 	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
@@ -283,10 +304,7 @@ func (b *Mattermost) handleMessage(ctx context.Context, mm mattermostMessage) er
 			IsKnown:          exists,
 			CommandOrigin:    command.TypedOrigin,
 		},
-		User: execute.UserInput{
-			//Mention:     "", // not used currently
-			DisplayName: userName,
-		},
+		User:    user,
 		Message: req,
 	})
 	response := e.Execute(ctx)
@@ -316,6 +334,60 @@ func (b *Mattermost) send(ctx context.Context, channelID string, resp interactiv
 	return nil
 }
 
+func (b *Mattermost) postMessage(ctx context.Context, channelID string, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	post, err := b.createPost(ctx, channelID, "", msg)
+	if err != nil {
+		return notifier.MessageRef{}, err
+	}
+	return notifier.MessageRef{ChannelID: channelID, MessageID: post.Id}, nil
+}
+
+func (b *Mattermost) postReply(ctx context.Context, parent notifier.MessageRef, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	_, err := b.createPost(ctx, parent.ChannelID, parent.MessageID, msg)
+	return parent, err
+}
+
+func (b *Mattermost) editMessage(ctx context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	post, err := b.formatMessage(ctx, msg, ref.ChannelID)
+	if err != nil {
+		return fmt.Errorf("while formatting message: %w", err)
+	}
+	// Too long messages are uploaded as files, which cannot be edited in place.
+	if len(post.FileIds) > 0 {
+		return errors.New("message is too long to be edited")
+	}
+
+	props := post.Props
+	if _, _, err := b.apiClient.PatchPost(ctx, ref.MessageID, &model.PostPatch{
+		Message: &post.Message,
+		Props:   &props,
+	}); err != nil {
+		return fmt.Errorf("while updating post: %w", err)
+	}
+	return nil
+}
+
+// createPost creates a new post in a given channel. If rootID is specified, the post is created in the thread of the root post.
+func (b *Mattermost) createPost(ctx context.Context, channelID, rootID string, msg interactive.CoreMessage) (*model.Post, error) {
+	b.log.Debugf("Sending message to channel %q: %+v", channelID, msg)
+
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	post, err := b.formatMessage(ctx, msg, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("while formatting message: %w", err)
+	}
+	post.RootId = rootID
+
+	created, _, err := b.apiClient.CreatePost(ctx, post)
+	if err != nil {
+		return nil, fmt.Errorf("while creating post: %w", err)
+	}
+
+	b.log.Debugf("Message successfully sent to channel %q", channelID)
+	return created, nil
+}
+
 func (b *Mattermost) formatMessage(ctx context.Context, msg interactive.CoreMessage, channelID string) (*model.Post, error) {
 	// 1. Check the size and upload message as a file if it's too long
 	plaintext := interactive.MessageToPlaintext(msg, interactive.NewlineFormatter)
@@ -414,6 +486,11 @@ func (b *Mattermost) listen(ctx context.Context) {
 	}
 }
 
+// ChannelsToNotify returns IDs of Mattermost channels bound to given sources.
+func (b *Mattermost) ChannelsToNotify(sourceBindings []string) []string {
+	return b.getChannelsToNotify(sourceBindings)
+}
+
 func (b *Mattermost) getChannelsToNotify(eventSources []string) []string {
 	var out []string
 	for _, cfg := range b.getChannels() {
@@ -430,17 +507,43 @@ func (b *Mattermost) getChannelsToNotify(eventSources []string) []string {
 }
 
 // SendMessage sends message to selected Mattermost channels.
-func (b *Mattermost) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *Mattermost) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string) ([]notifier.MessageRef, error) {
+	var refs []notifier.MessageRef
+	var failed []string
 	errs := multierror.New()
-	for _, channelID := range b.getChannelsToNotify(sourceBindings) {
-		err := b.send(ctx, channelID, msg)
+	for _, channelID := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
+		ref, err := b.postMessage(ctx, channelID, msg)
 		if err != nil {
+			failed = append(failed, channelID)
 			errs = multierror.Append(errs, fmt.Errorf("while sending Mattermost message to channel %q: %w", channelID, err))
 			continue
 		}
+		ref.Channel = channelID
+		refs = append(refs, ref)
 	}
 
-	return errs.ErrorOrNil()
+	return refs, notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
+}
+
+// EditMessage replaces the content of a given Mattermost post.
+func (b *Mattermost) EditMessage(ctx context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	return b.editMessage(ctx, ref, msg)
+}
+
+// SendCorrelatedMessage sends message to selected Mattermost channels. Messages with the same correlation key are posted in a single thread.
+func (b *Mattermost) SendCorrelatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string, correlationKey string) error {
+	var failed []string
+	errs := multierror.New()
+	for _, channelID := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
+		err := b.msgRefs.Send(ctx, b, channelID, correlationKey, msg)
+		if err != nil {
+			failed = append(failed, channelID)
+			errs = multierror.Append(errs, fmt.Errorf("while sending Mattermost message to channel %q: %w", channelID, err))
+			continue
+		}
+	}
+
+	return notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
 }
 
 // SendMessageToAll sends message to all Mattermost channels.
@@ -482,19 +585,34 @@ func (b *Mattermost) setChannels(channels map[string]channelConfigByID) {
 	b.channels = channels
 }
 
-func (b *Mattermost) getUserName(ctx context.Context, userID string) (string, error) {
-	userName, exists := b.userNamesForID[userID]
-	if exists {
-		return userName, nil
+type cachedMattermostUser struct {
+	user      *model.User
+	fetchedAt time.Time
+}
+
+func (b *Mattermost) getUser(ctx context.Context, userID string) (*model.User, error) {
+	b.usersMutex.Lock()
+	cached, exists := b.usersForID[userID]
+	b.usersMutex.Unlock()
+	if exists && time.Since(cached.fetchedAt) < mattermostUserCacheTTL {
+		return cached.user, nil
 	}
 
 	user, _, err := b.apiClient.GetUser(ctx, userID, "")
 	if err != nil {
-		return "", fmt.Errorf("while getting user with ID %q: %w", userID, err)
+		return nil, fmt.Errorf("while getting user with ID %q: %w", userID, err)
+	}
+
+	b.usersMutex.Lock()
+	defer b.usersMutex.Unlock()
+	for id, cached := range b.usersForID {
+		if time.Since(cached.fetchedAt) >= mattermostUserCacheTTL {
+			delete(b.usersForID, id)
+		}
 	}
-	b.userNamesForID[userID] = user.Username
+	b.usersForID[userID] = cachedMattermostUser{user: user, fetchedAt: time.Now()}
 
-	return user.Username, nil
+	return user, nil
 }
 
 func (b *Mattermost) shutdown() {
@@ -0,0 +1,141 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/syncx"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+const (
+	// msgRefTTL defines how long follow-up events are posted in the thread of the first message.
+	msgRefTTL = 24 * time.Hour
+	// msgRefMaxItems limits the number of tracked threads per bot.
+	msgRefMaxItems = 1000
+)
+
+// correlatedMessageSender posts and edits messages on a given communication platform.
+type correlatedMessageSender interface {
+	// postMessage posts a new message to a given channel.
+	postMessage(ctx context.Context, channel string, msg interactive.CoreMessage) (notifier.MessageRef, error)
+	// postReply posts a message in the thread of the parent message. It returns the parent reference, updated if needed.
+	postReply(ctx context.Context, parent notifier.MessageRef, msg interactive.CoreMessage) (notifier.MessageRef, error)
+	// editMessage replaces the content of a given message.
+	editMessage(ctx context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error
+}
+
+type msgRefEntry struct {
+	ref       notifier.MessageRef
+	updatedAt time.Time
+}
+
+// MessageRefStore keeps references to the first messages posted for a given correlation key,
+// so follow-up messages can be posted as thread replies.
+type MessageRefStore struct {
+	log      logrus.FieldLogger
+	ttl      time.Duration
+	maxItems int
+	now      func() time.Time
+
+	// keyLocks serializes sending messages for a given correlation key, while mu guards only the items map.
+	keyLocks syncx.KeyedMutex
+
+	mu    sync.Mutex
+	items map[string]msgRefEntry
+}
+
+// NewMessageRefStore returns a new MessageRefStore instance.
+func NewMessageRefStore(log logrus.FieldLogger) *MessageRefStore {
+	return &MessageRefStore{
+		log:      log,
+		ttl:      msgRefTTL,
+		maxItems: msgRefMaxItems,
+		now:      time.Now,
+		items:    map[string]msgRefEntry{},
+	}
+}
+
+// Send posts a given message to a given channel. If there was already a message posted for a given correlation key,
+// the message is posted as a thread reply, and the first message is edited to show the latest status.
+func (s *MessageRefStore) Send(ctx context.Context, sender correlatedMessageSender, channel, correlationKey string, msg interactive.CoreMessage) error {
+	key := fmt.Sprintf("%s/%s", channel, correlationKey)
+
+	// Correlated messages are sent sequentially to make sure that a single thread is started for a given key.
+	unlock := s.keyLocks.Lock(key)
+	defer unlock()
+
+	parent, found := s.get(key)
+	if !found {
+		ref, err := sender.postMessage(ctx, channel, msg)
+		if err != nil {
+			return err
+		}
+		s.set(key, ref)
+		return nil
+	}
+
+	parent, err := sender.postReply(ctx, parent, msg)
+	if err != nil {
+		return fmt.Errorf("while posting thread reply: %w", err)
+	}
+	s.set(key, parent)
+
+	// The reply was already delivered, so don't return the error to avoid duplicated replies on retries.
+	if err := sender.editMessage(ctx, parent, msg); err != nil {
+		s.log.WithError(err).WithField("correlationKey", correlationKey).Warn("Cannot update the thread parent message with the latest status.")
+	}
+	return nil
+}
+
+func (s *MessageRefStore) get(key string) (notifier.MessageRef, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, found := s.items[key]
+	if !found {
+		return notifier.MessageRef{}, false
+	}
+	if s.now().Sub(entry.updatedAt) > s.ttl {
+		delete(s.items, key)
+		return notifier.MessageRef{}, false
+	}
+	return entry.ref, true
+}
+
+func (s *MessageRefStore) set(key string, ref notifier.MessageRef) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.items[key]; !found && len(s.items) >= s.maxItems {
+		s.evict()
+	}
+	s.items[key] = msgRefEntry{ref: ref, updatedAt: s.now()}
+}
+
+// evict removes expired entries. If there are none, the least recently updated entry is removed.
+// It must be called with the mutex held.
+func (s *MessageRefStore) evict() {
+	var (
+		oldestKey string
+		oldest    time.Time
+	)
+	for key, entry := range s.items {
+		if s.now().Sub(entry.updatedAt) > s.ttl {
+			delete(s.items, key)
+			continue
+		}
+		if oldestKey == "" || entry.updatedAt.Before(oldest) {
+			oldestKey, oldest = key, entry.updatedAt
+		}
+	}
+
+	if len(s.items) >= s.maxItems {
+		delete(s.items, oldestKey)
+	}
+}
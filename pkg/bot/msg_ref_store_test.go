@@ -0,0 +1,110 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+func TestMessageRefStoreSend(t *testing.T) {
+	// given
+	store := NewMessageRefStore(loggerx.NewNoop())
+	sender := &fakeCorrelatedSender{}
+
+	created := interactive.CoreMessage{Message: api.NewPlaintextMessage("Deployment created", false)}
+	scaled := interactive.CoreMessage{Message: api.NewPlaintextMessage("ReplicaSet scaled", false)}
+	failed := interactive.CoreMessage{Message: api.NewPlaintextMessage("Pod failed", false)}
+	other := interactive.CoreMessage{Message: api.NewPlaintextMessage("Service created", false)}
+
+	// when
+	require.NoError(t, store.Send(context.Background(), sender, "general", "apps/v1/Deployment/default/nginx", created))
+	require.NoError(t, store.Send(context.Background(), sender, "general", "apps/v1/Deployment/default/nginx", scaled))
+	require.NoError(t, store.Send(context.Background(), sender, "general", "apps/v1/Deployment/default/nginx", failed))
+	require.NoError(t, store.Send(context.Background(), sender, "general", "v1/Service/default/nginx", other))
+	require.NoError(t, store.Send(context.Background(), sender, "random", "apps/v1/Deployment/default/nginx", scaled))
+
+	// then
+	assert.Equal(t, []string{
+		"post general/msg-1: Deployment created",
+		"reply general/msg-1: ReplicaSet scaled",
+		"edit general/msg-1: ReplicaSet scaled",
+		"reply general/msg-1: Pod failed",
+		"edit general/msg-1: Pod failed",
+		"post general/msg-2: Service created",
+		"post random/msg-3: ReplicaSet scaled",
+	}, sender.calls)
+}
+
+func TestMessageRefStoreSendAfterExpiration(t *testing.T) {
+	// given
+	now := time.Now()
+	store := NewMessageRefStore(loggerx.NewNoop())
+	store.now = func() time.Time { return now }
+	sender := &fakeCorrelatedSender{}
+
+	msg := interactive.CoreMessage{Message: api.NewPlaintextMessage("Pod failed", false)}
+
+	// when
+	require.NoError(t, store.Send(context.Background(), sender, "general", "v1/Pod/default/nginx", msg))
+	now = now.Add(msgRefTTL + time.Second)
+	require.NoError(t, store.Send(context.Background(), sender, "general", "v1/Pod/default/nginx", msg))
+
+	// then
+	assert.Equal(t, []string{
+		"post general/msg-1: Pod failed",
+		"post general/msg-2: Pod failed",
+	}, sender.calls)
+}
+
+func TestMessageRefStoreSendIgnoresEditErrors(t *testing.T) {
+	// given
+	store := NewMessageRefStore(loggerx.NewNoop())
+	sender := &fakeCorrelatedSender{editErr: errors.New("message not found")}
+
+	msg := interactive.CoreMessage{Message: api.NewPlaintextMessage("Pod failed", false)}
+
+	// when
+	require.NoError(t, store.Send(context.Background(), sender, "general", "v1/Pod/default/nginx", msg))
+	err := store.Send(context.Background(), sender, "general", "v1/Pod/default/nginx", msg)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"post general/msg-1: Pod failed",
+		"reply general/msg-1: Pod failed",
+		"edit general/msg-1: Pod failed",
+	}, sender.calls)
+}
+
+type fakeCorrelatedSender struct {
+	lastID  int
+	editErr error
+	calls   []string
+}
+
+func (f *fakeCorrelatedSender) postMessage(_ context.Context, channel string, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	f.lastID++
+	ref := notifier.MessageRef{ChannelID: channel, MessageID: fmt.Sprintf("msg-%d", f.lastID)}
+	f.calls = append(f.calls, fmt.Sprintf("post %s/%s: %s", ref.ChannelID, ref.MessageID, msg.BaseBody.Plaintext))
+	return ref, nil
+}
+
+func (f *fakeCorrelatedSender) postReply(_ context.Context, parent notifier.MessageRef, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	f.calls = append(f.calls, fmt.Sprintf("reply %s/%s: %s", parent.ChannelID, parent.MessageID, msg.BaseBody.Plaintext))
+	return parent, nil
+}
+
+func (f *fakeCorrelatedSender) editMessage(_ context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	f.calls = append(f.calls, fmt.Sprintf("edit %s/%s: %s", ref.ChannelID, ref.MessageID, msg.BaseBody.Plaintext))
+	return f.editErr
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/formatx"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -44,7 +45,11 @@ const (
 	quotaExceededMsg        = "Quota exceeded detected. Stopping reconnecting to Botkube Cloud gRPC API..."
 )
 
-var _ Bot = &CloudSlack{}
+var (
+	_ Bot                  = &CloudSlack{}
+	_ notifier.ThreadedBot = &CloudSlack{}
+	_ notifier.EditableBot = &CloudSlack{}
+)
 
 // CloudSlack listens for user's message, execute commands and sends back the response.
 type CloudSlack struct {
@@ -64,6 +69,7 @@ type CloudSlack struct {
 	clusterName      string
 	msgStatusTracker *SlackMessageStatusTracker
 	messages         chan *pb.ConnectResponse
+	msgRefs          *MessageRefStore
 	messageWorkers   *pool.Pool
 	shutdownOnce     sync.Once
 }
@@ -112,6 +118,7 @@ func NewCloudSlack(log logrus.FieldLogger,
 		realNamesForID:   map[string]string{},
 		msgStatusTracker: NewSlackMessageStatusTracker(log, client),
 		messages:         make(chan *pb.ConnectResponse, platformMessageChannelSize),
+		msgRefs:          NewMessageRefStore(log),
 		messageWorkers:   pool.New().WithMaxGoroutines(platformMessageWorkersCount),
 	}, nil
 }
@@ -382,22 +389,43 @@ func (b *CloudSlack) handleStreamMessage(ctx context.Context, data *pb.ConnectRe
 	return nil, false
 }
 
-func (b *CloudSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *CloudSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string) ([]notifier.MessageRef, error) {
+	var refs []notifier.MessageRef
+	var failed []string
 	errs := multierror.New()
-	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
-		msgMetadata := slackMessage{
-			Channel:         channelName,
-			ThreadTimeStamp: "",
-			BlockID:         uuid.New().String(),
+	for _, channelName := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
+		ref, err := b.postMessage(ctx, channelName, msg)
+		if err != nil {
+			failed = append(failed, channelName)
+			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
+			continue
 		}
-		err := b.send(ctx, msgMetadata, msg)
+		ref.Channel = channelName
+		refs = append(refs, ref)
+	}
+
+	return refs, notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
+}
+
+// EditMessage replaces the content of a given Slack message.
+func (b *CloudSlack) EditMessage(ctx context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	return b.editMessage(ctx, ref, msg)
+}
+
+// SendCorrelatedMessage sends message to selected Slack channels. Messages with the same correlation key are posted in a single thread.
+func (b *CloudSlack) SendCorrelatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string, correlationKey string) error {
+	var failed []string
+	errs := multierror.New()
+	for _, channelName := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
+		err := b.msgRefs.Send(ctx, b, channelName, correlationKey, msg)
 		if err != nil {
+			failed = append(failed, channelName)
 			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
 			continue
 		}
 	}
 
-	return errs.ErrorOrNil()
+	return notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
 }
 
 func (b *CloudSlack) SendMessageToAll(ctx context.Context, msg interactive.CoreMessage) error {
@@ -488,6 +516,7 @@ func (b *CloudSlack) handleMessage(ctx context.Context, event slackMessage) erro
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", event.UserID),
 			DisplayName: event.UserName,
+			ID:          event.UserID,
 		},
 	})
 
@@ -506,13 +535,18 @@ func (b *CloudSlack) handleMessage(ctx context.Context, event slackMessage) erro
 }
 
 func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interactive.CoreMessage) error {
+	_, err := b.post(ctx, event, resp)
+	return err
+}
+
+func (b *CloudSlack) post(ctx context.Context, event slackMessage, resp interactive.CoreMessage) (notifier.MessageRef, error) {
 	b.log.Debugf("Sending message to channel %q: %+v", event.Channel, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName(), api.BotNameWithClusterName(b.clusterName))
 	markdown := b.renderer.MessageToMarkdown(resp)
 
 	if len(markdown) == 0 {
-		return errors.New("while reading Slack response: empty response")
+		return notifier.MessageRef{}, errors.New("while reading Slack response: empty response")
 	}
 
 	// Upload message as a file if too long
@@ -521,7 +555,7 @@ func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interact
 	if len(markdown) >= slackMaxMessageSize {
 		file, err = uploadFileToSlack(ctx, event.Channel, resp, b.client, event.ThreadTimeStamp)
 		if err != nil {
-			return err
+			return notifier.MessageRef{}, err
 		}
 		resp = interactive.CoreMessage{
 			Message: api.Message{
@@ -555,18 +589,39 @@ func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interact
 		options = append(options, slack.MsgOptionReplaceOriginal(event.ResponseURL))
 	}
 
+	var ref notifier.MessageRef
 	if resp.OnlyVisibleForYou {
 		if _, err := b.client.PostEphemeralContext(ctx, event.Channel, event.UserID, options...); err != nil {
-			return fmt.Errorf("while posting Slack message visible only to user: %w", err)
+			return notifier.MessageRef{}, fmt.Errorf("while posting Slack message visible only to user: %w", err)
 		}
 	} else {
-		if _, _, err := b.client.PostMessageContext(ctx, event.Channel, options...); err != nil {
-			return fmt.Errorf("while posting Slack message: %w", err)
+		channelID, ts, err := b.client.PostMessageContext(ctx, event.Channel, options...)
+		if err != nil {
+			return notifier.MessageRef{}, fmt.Errorf("while posting Slack message: %w", err)
 		}
+		ref = notifier.MessageRef{ChannelID: channelID, MessageID: ts}
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", event.Channel)
-	return nil
+	return ref, nil
+}
+
+func (b *CloudSlack) postMessage(ctx context.Context, channel string, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	return b.post(ctx, slackMessage{Channel: channel, BlockID: uuid.New().String()}, msg)
+}
+
+func (b *CloudSlack) postReply(ctx context.Context, parent notifier.MessageRef, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	_, err := b.post(ctx, slackMessage{
+		Channel:         parent.ChannelID,
+		ThreadTimeStamp: parent.MessageID,
+		BlockID:         uuid.New().String(),
+	}, msg)
+	return parent, err
+}
+
+func (b *CloudSlack) editMessage(ctx context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	msg.ReplaceBotNamePlaceholder(b.BotName(), api.BotNameWithClusterName(b.clusterName))
+	return updateSlackMessage(ctx, b.client, b.renderer, ref, msg)
 }
 
 func (b *CloudSlack) findAndTrimBotMention(msg string) (string, bool) {
@@ -642,6 +697,11 @@ func (b *CloudSlack) setChannels(channels map[string]channelConfigByName) {
 	b.channels = channels
 }
 
+// ChannelsToNotify returns names of Slack channels bound to given sources.
+func (b *CloudSlack) ChannelsToNotify(sourceBindings []string) []string {
+	return b.getChannelsToNotify(sourceBindings)
+}
+
 func (b *CloudSlack) getChannelsToNotify(sourceBindings []string) []string {
 	var out []string
 	for _, cfg := range b.getChannels() {
@@ -17,6 +17,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -267,6 +268,7 @@ func (b *Slack) handleMessage(ctx context.Context, msg slackLegacyMessage) error
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", msg.User),
 			DisplayName: msg.User, // this integration is officially not supported, so no need to ensure it has a nice display name
+			ID:          msg.User,
 		},
 	})
 	response := e.Execute(ctx)
@@ -318,6 +320,11 @@ func (b *Slack) send(ctx context.Context, msg slackLegacyMessage, resp interacti
 	return nil
 }
 
+// ChannelsToNotify returns names of Slack channels bound to given sources.
+func (b *Slack) ChannelsToNotify(sourceBindings []string) []string {
+	return b.getChannelsToNotify(sourceBindings)
+}
+
 func (b *Slack) getChannelsToNotify(sourceBindings []string) []string {
 	var out []string
 	for _, cfg := range b.getChannels() {
@@ -336,21 +343,24 @@ func (b *Slack) getChannelsToNotify(sourceBindings []string) []string {
 }
 
 // SendMessage sends message to selected Slack channels.
-func (b *Slack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+// Messages sent by the legacy Slack integration are not tracked, so no message references are returned.
+func (b *Slack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string) ([]notifier.MessageRef, error) {
+	var failed []string
 	errs := multierror.New()
-	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
+	for _, channelName := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
 		msgMetadata := slackLegacyMessage{
 			Channel:         channelName,
 			ThreadTimeStamp: "",
 		}
 		err := b.send(ctx, msgMetadata, msg, false)
 		if err != nil {
+			failed = append(failed, channelName)
 			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
 			continue
 		}
 	}
 
-	return errs.ErrorOrNil()
+	return nil, notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
 }
 
 // SendMessageToAll sends message to all Slack channels.
@@ -1,15 +1,19 @@
 package bot
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
 
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
 	conversationx "github.com/kubeshop/botkube/pkg/conversation"
 	"github.com/kubeshop/botkube/pkg/execute/command"
+	"github.com/kubeshop/botkube/pkg/notifier"
 )
 
 const slackBotMentionPrefixFmt = "^<@%s>"
@@ -54,6 +58,19 @@ func slackError(err error, channel string) error {
 	return err
 }
 
+// updateSlackMessage replaces the content of a given Slack message.
+func updateSlackMessage(ctx context.Context, client *slack.Client, renderer *SlackRenderer, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	// Too long messages are uploaded as files, which cannot be edited in place.
+	if len(renderer.MessageToMarkdown(msg)) >= slackMaxMessageSize {
+		return errors.New("message is too long to be edited")
+	}
+
+	if _, _, _, err := client.UpdateMessageContext(ctx, ref.ChannelID, ref.MessageID, renderer.RenderInteractiveMessage(msg)); err != nil {
+		return fmt.Errorf("while updating Slack message: %w", slackError(err, ref.ChannelID))
+	}
+	return nil
+}
+
 // slackMessage contains message details to execute command and send back the result
 type slackMessage struct {
 	Text            string
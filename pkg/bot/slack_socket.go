@@ -23,6 +23,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/formatx"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -30,7 +31,11 @@ import (
 //    - split to multiple files in a separate package,
 //    - review all the methods and see if they can be simplified.
 
-var _ Bot = &SocketSlack{}
+var (
+	_ Bot                  = &SocketSlack{}
+	_ notifier.ThreadedBot = &SocketSlack{}
+	_ notifier.EditableBot = &SocketSlack{}
+)
 
 // SocketSlack listens for user's message, execute commands and sends back the response.
 type SocketSlack struct {
@@ -48,6 +53,7 @@ type SocketSlack struct {
 	realNamesForID   map[string]string
 	msgStatusTracker *SlackMessageStatusTracker
 	messages         chan slackMessage
+	msgRefs          *MessageRefStore
 	messageWorkers   *pool.Pool
 	shutdownOnce     sync.Once
 }
@@ -91,6 +97,7 @@ func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.Soc
 		realNamesForID:   map[string]string{},
 		msgStatusTracker: NewSlackMessageStatusTracker(log, client),
 		messages:         make(chan slackMessage, platformMessageChannelSize),
+		msgRefs:          NewMessageRefStore(log),
 		messageWorkers:   pool.New().WithMaxGoroutines(platformMessageWorkersCount),
 	}, nil
 }
@@ -376,6 +383,7 @@ func (b *SocketSlack) handleMessage(ctx context.Context, event slackMessage) err
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", event.UserID),
 			DisplayName: event.UserName,
+			ID:          event.UserID,
 		},
 	})
 
@@ -394,13 +402,18 @@ func (b *SocketSlack) handleMessage(ctx context.Context, event slackMessage) err
 }
 
 func (b *SocketSlack) send(ctx context.Context, event slackMessage, resp interactive.CoreMessage) error {
+	_, err := b.post(ctx, event, resp)
+	return err
+}
+
+func (b *SocketSlack) post(ctx context.Context, event slackMessage, resp interactive.CoreMessage) (notifier.MessageRef, error) {
 	b.log.Debugf("Sending message to channel %q: %+v", event.Channel, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName())
 	markdown := b.renderer.MessageToMarkdown(resp)
 
 	if len(markdown) == 0 {
-		return errors.New("while reading Slack response: empty response")
+		return notifier.MessageRef{}, errors.New("while reading Slack response: empty response")
 	}
 
 	// Upload message as a file if too long
@@ -409,7 +422,7 @@ func (b *SocketSlack) send(ctx context.Context, event slackMessage, resp interac
 	if len(markdown) >= slackMaxMessageSize {
 		file, err = uploadFileToSlack(ctx, event.Channel, resp, b.client, event.ThreadTimeStamp)
 		if err != nil {
-			return err
+			return notifier.MessageRef{}, err
 		}
 		resp = interactive.CoreMessage{
 			Message: api.Message{
@@ -424,9 +437,9 @@ func (b *SocketSlack) send(ctx context.Context, event slackMessage, resp interac
 		modalView.PrivateMetadata = event.Channel
 		_, err := b.client.OpenViewContext(ctx, event.TriggerID, modalView)
 		if err != nil {
-			return fmt.Errorf("while opening modal: %w", err)
+			return notifier.MessageRef{}, fmt.Errorf("while opening modal: %w", err)
 		}
-		return nil
+		return notifier.MessageRef{}, nil
 	}
 
 	options := []slack.MsgOption{
@@ -441,18 +454,44 @@ func (b *SocketSlack) send(ctx context.Context, event slackMessage, resp interac
 		options = append(options, slack.MsgOptionReplaceOriginal(event.ResponseURL))
 	}
 
+	var ref notifier.MessageRef
 	if resp.OnlyVisibleForYou {
 		if _, err := b.client.PostEphemeralContext(ctx, event.Channel, event.UserID, options...); err != nil {
-			return fmt.Errorf("while posting Slack message visible only to user: %w", err)
+			return notifier.MessageRef{}, fmt.Errorf("while posting Slack message visible only to user: %w", err)
 		}
 	} else {
-		if _, _, err := b.client.PostMessageContext(ctx, event.Channel, options...); err != nil {
-			return fmt.Errorf("while posting Slack message: %w", slackError(err, event.Channel))
+		channelID, ts, err := b.client.PostMessageContext(ctx, event.Channel, options...)
+		if err != nil {
+			return notifier.MessageRef{}, fmt.Errorf("while posting Slack message: %w", slackError(err, event.Channel))
 		}
+		ref = notifier.MessageRef{ChannelID: channelID, MessageID: ts}
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", event.Channel)
-	return nil
+	return ref, nil
+}
+
+func (b *SocketSlack) postMessage(ctx context.Context, channel string, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	return b.post(ctx, slackMessage{Channel: channel, BlockID: uuid.New().String()}, msg)
+}
+
+func (b *SocketSlack) postReply(ctx context.Context, parent notifier.MessageRef, msg interactive.CoreMessage) (notifier.MessageRef, error) {
+	_, err := b.post(ctx, slackMessage{
+		Channel:         parent.ChannelID,
+		ThreadTimeStamp: parent.MessageID,
+		BlockID:         uuid.New().String(),
+	}, msg)
+	return parent, err
+}
+
+func (b *SocketSlack) editMessage(ctx context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	return updateSlackMessage(ctx, b.client, b.renderer, ref, msg)
+}
+
+// ChannelsToNotify returns names of Slack channels bound to given sources.
+func (b *SocketSlack) ChannelsToNotify(sourceBindings []string) []string {
+	return b.getChannelsToNotify(sourceBindings)
 }
 
 func (b *SocketSlack) getChannelsToNotify(sourceBindings []string) []string {
@@ -473,22 +512,43 @@ func (b *SocketSlack) getChannelsToNotify(sourceBindings []string) []string {
 }
 
 // SendMessage sends message with interactive sections to selected Slack channels.
-func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string) ([]notifier.MessageRef, error) {
+	var refs []notifier.MessageRef
+	var failed []string
 	errs := multierror.New()
-	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
-		msgMetadata := slackMessage{
-			Channel:         channelName,
-			ThreadTimeStamp: "",
-			BlockID:         uuid.New().String(),
+	for _, channelName := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
+		ref, err := b.postMessage(ctx, channelName, msg)
+		if err != nil {
+			failed = append(failed, channelName)
+			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
+			continue
 		}
-		err := b.send(ctx, msgMetadata, msg)
+		ref.Channel = channelName
+		refs = append(refs, ref)
+	}
+
+	return refs, notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
+}
+
+// EditMessage replaces the content of a given Slack message.
+func (b *SocketSlack) EditMessage(ctx context.Context, ref notifier.MessageRef, msg interactive.CoreMessage) error {
+	return b.editMessage(ctx, ref, msg)
+}
+
+// SendCorrelatedMessage sends message to selected Slack channels. Messages with the same correlation key are posted in a single thread.
+func (b *SocketSlack) SendCorrelatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string, correlationKey string) error {
+	var failed []string
+	errs := multierror.New()
+	for _, channelName := range notifier.FilterChannels(b.getChannelsToNotify(sourceBindings), channels) {
+		err := b.msgRefs.Send(ctx, b, channelName, correlationKey, msg)
 		if err != nil {
+			failed = append(failed, channelName)
 			errs = multierror.Append(errs, fmt.Errorf("while sending Slack message to channel %q: %w", channelName, err))
 			continue
 		}
 	}
 
-	return errs.ErrorOrNil()
+	return notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
 }
 
 // SendMessageToAll sends message with interactive sections to all Slack channels.
@@ -23,13 +23,13 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
 // TODO: Refactor this file as a part of https://github.com/kubeshop/botkube/issues/667
-//  - We can set conversation ref without waiting for `@Botkube notify start` message.
+//  - We can set conversation ref without waiting for the first message in a given channel.
 //    It just a matter of handling the onConversationUpdate event and caching conversation ref for a given channel.
-//  - Support source and executor bindings per channel.
 //  - Review all the methods and see if they can be simplified.
 
 const (
@@ -57,6 +57,9 @@ var mdEmojiTag = regexp.MustCompile(`:(\w+):`)
 type conversation struct {
 	ref    schema.ConversationReference
 	notify bool
+
+	// channelName is the identifier of a channel defined in configuration. Empty for other conversations.
+	channelName string
 }
 
 // Teams listens for user's message, execute commands and sends back the response.
@@ -64,8 +67,9 @@ type Teams struct {
 	log             logrus.FieldLogger
 	executorFactory ExecutorFactory
 	reporter        AnalyticsReporter
-	// TODO: Be consistent with other communicators when Teams supports multiple channels
-	//channels map[string][ChannelBindingsByName]
+	channelsMutex   sync.RWMutex
+	channels        map[string]channelConfigByName
+	// bindings are used for conversations which are not defined in channels configuration
 	bindings           config.BotBindings
 	conversationsMutex sync.RWMutex
 	commGroupName      string
@@ -111,6 +115,7 @@ func NewTeams(log logrus.FieldLogger, commGroupName string, cfg config.Teams, cl
 		ClusterName:     clusterName,
 		AppID:           cfg.AppID,
 		AppPassword:     cfg.AppPassword,
+		channels:        teamsChannelsConfigFrom(cfg.Channels),
 		bindings:        cfg.Bindings,
 		commGroupName:   commGroupName,
 		MessagePath:     msgPath,
@@ -279,21 +284,34 @@ func (b *Teams) processMessage(ctx context.Context, activity schema.Activity) (i
 		return 0, ""
 	}
 
+	conv := execute.Conversation{
+		IsKnown:          true,
+		ID:               ref.ChannelID,
+		ExecutorBindings: b.bindings.Executors,
+		SourceBindings:   b.bindings.Sources,
+		CommandOrigin:    command.TypedOrigin,
+	}
+	channel, exists := b.findChannel(ref.ChannelID, teamsChannelName(activity))
+	if exists {
+		// cache the reference, so notifications can be sent to this channel without an explicit `enable notifications` command
+		b.registerConversation(ref, channel.Identifier())
+
+		conv.Alias = channel.alias
+		conv.DisplayName = channel.Identifier()
+		conv.ID = channel.Identifier()
+		conv.ExecutorBindings = channel.Bindings.Executors
+		conv.SourceBindings = channel.Bindings.Sources
+	}
+
 	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: newTeamsNotifMgrForActivity(b, ref),
-		Conversation: execute.Conversation{
-			Alias:            "",
-			IsKnown:          true,
-			ID:               ref.ChannelID,
-			ExecutorBindings: b.bindings.Executors,
-			SourceBindings:   b.bindings.Sources,
-			CommandOrigin:    command.TypedOrigin,
-		},
+		Conversation:    conv,
 		User: execute.UserInput{
 			//Mention:     "", // not used currently
 			DisplayName: activity.From.Name,
+			ID:          teamsUserID(activity.From),
 		},
 		Message: trimmedMsg,
 	})
@@ -344,17 +362,22 @@ func (b *Teams) putRequest(u string, data []byte) (err error) {
 }
 
 // SendMessage sends message to MS Teams to selected conversations.
-func (b *Teams) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+// Teams messages cannot be edited, so no message references are returned.
+func (b *Teams) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string) ([]notifier.MessageRef, error) {
 	msg.ReplaceBotNamePlaceholder(b.BotName())
+	var failed []string
 	errs := multierror.New()
 
 	activityMsg, err := b.renderMessage(msg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, ref := range b.getConversationRefsToNotify(sourceBindings) {
 		channelID := ref.ChannelID
+		if len(notifier.FilterChannels([]string{channelID}, channels)) == 0 {
+			continue
+		}
 		b.log.Debugf("Sending message to channel %q", channelID)
 		err := b.Adapter.ProactiveMessage(ctx, ref, coreActivity.HandlerFuncs{
 			OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
@@ -362,13 +385,14 @@ func (b *Teams) SendMessage(ctx context.Context, msg interactive.CoreMessage, so
 			},
 		})
 		if err != nil {
+			failed = append(failed, channelID)
 			errs = multierror.Append(errs, fmt.Errorf("while sending Teams message to channel %q: %w", channelID, err))
 			continue
 		}
 		b.log.Debugf("Message successfully sent to channel %q", channelID)
 	}
 
-	return errs.ErrorOrNil()
+	return nil, notifier.NewChannelDeliveryError(failed, errs.ErrorOrNil())
 }
 
 // SendMessageToAll sends message to MS Teams to all conversations.
@@ -405,14 +429,21 @@ func (b *Teams) Type() config.IntegrationType {
 	return config.BotIntegrationType
 }
 
-// NotificationsEnabled returns current notification status for a given channel ID.
+// NotificationsEnabled returns current notification status for a given channel ID or configured channel name.
 func (b *Teams) NotificationsEnabled(channelID string) bool {
-	channel, exists := b.getConversations()[channelID]
+	if channel, exists := b.getChannels()[channelID]; exists {
+		return channel.notify
+	}
+
+	conv, exists := b.getConversations()[channelID]
 	if !exists {
 		return false
 	}
+	if conv.channelName != "" {
+		return b.getChannels()[conv.channelName].notify
+	}
 
-	return channel.notify
+	return conv.notify
 }
 
 // SetNotificationsEnabled sets a new notification status for a given channel ID.
@@ -423,6 +454,15 @@ func (b *Teams) SetNotificationsEnabled(enabled bool, ref schema.ConversationRef
 
 	conversations := b.getConversations()
 	conv, exists := conversations[ref.ChannelID]
+	if exists && conv.channelName != "" {
+		channels := b.getChannels()
+		channel := channels[conv.channelName]
+		channel.notify = enabled
+		channels[conv.channelName] = channel
+		b.setChannels(channels)
+		return nil
+	}
+
 	if !exists {
 		// not returning execute.ErrNotificationsNotConfigured error, as MS Teams channels are configured dynamically.
 		// In such case this shouldn't be considered as an error.
@@ -466,15 +506,31 @@ func (b *Teams) renderMessage(msg interactive.CoreMessage) (coreActivity.MsgOpti
 	return coreActivity.MsgOptionAttachments(attachments), nil
 }
 
+// ChannelsToNotify returns IDs of Teams channels bound to given sources.
+func (b *Teams) ChannelsToNotify(sourceBindings []string) []string {
+	var out []string
+	for _, ref := range b.getConversationRefsToNotify(sourceBindings) {
+		out = append(out, ref.ChannelID)
+	}
+	return out
+}
+
 func (b *Teams) getConversationRefsToNotify(sourceBindings []string) []schema.ConversationReference {
+	channels := b.getChannels()
+
 	var convRefsToNotify []schema.ConversationReference
 	for _, convConfig := range b.getConversations() {
-		if !convConfig.notify {
+		notify, bindings := convConfig.notify, b.bindings.Sources
+		if channel, exists := channels[convConfig.channelName]; convConfig.channelName != "" && exists {
+			notify, bindings = channel.notify, channel.Bindings.Sources
+		}
+
+		if !notify {
 			b.log.Infof("Skipping notification for channel %q as notifications are disabled.", convConfig.ref.ChannelID)
 			continue
 		}
 
-		if !sliceutil.Intersect(sourceBindings, b.bindings.Sources) {
+		if !sliceutil.Intersect(sourceBindings, bindings) {
 			continue
 		}
 
@@ -483,6 +539,44 @@ func (b *Teams) getConversationRefsToNotify(sourceBindings []string) []schema.Co
 	return convRefsToNotify
 }
 
+// findChannel returns configuration of a channel matching a given Teams channel ID or name.
+func (b *Teams) findChannel(channelID, channelName string) (channelConfigByName, bool) {
+	channels := b.getChannels()
+	if channel, exists := channels[channelID]; exists {
+		return channel, true
+	}
+	if channelName == "" {
+		return channelConfigByName{}, false
+	}
+	channel, exists := channels[channelName]
+	return channel, exists
+}
+
+// registerConversation stores the reference for a configured channel.
+func (b *Teams) registerConversation(ref schema.ConversationReference, channelName string) {
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	conversations := b.getConversations()
+	conversations[ref.ChannelID] = conversation{
+		ref:         ref,
+		channelName: channelName,
+	}
+	b.setConversations(conversations)
+}
+
+func (b *Teams) getChannels() map[string]channelConfigByName {
+	b.channelsMutex.RLock()
+	defer b.channelsMutex.RUnlock()
+	return b.channels
+}
+
+func (b *Teams) setChannels(channels map[string]channelConfigByName) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+	b.channels = channels
+}
+
 func (b *Teams) getConversations() map[string]conversation {
 	b.conversationsMutex.RLock()
 	defer b.conversationsMutex.RUnlock()
@@ -572,3 +666,34 @@ var emojiMapping = map[string]string{
 	":exclamation:":             "❗",
 	":cricket:":                 "🦗",
 }
+
+func teamsChannelsConfigFrom(channelsCfg config.IdentifiableMap[config.ChannelBindingsByName]) map[string]channelConfigByName {
+	channels := make(map[string]channelConfigByName)
+	for channAlias, channCfg := range channelsCfg {
+		channels[channCfg.Identifier()] = channelConfigByName{
+			ChannelBindingsByName: channCfg,
+			alias:                 channAlias,
+			notify:                !channCfg.Notification.Disabled,
+		}
+	}
+
+	return channels
+}
+
+// teamsChannelName returns the channel name from activity channel data. The name is not set for the General channel.
+func teamsChannelName(activity schema.Activity) string {
+	rawChannel, ok := activity.ChannelData["channel"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	name, _ := rawChannel["name"].(string)
+	return name
+}
+
+// teamsUserID returns the Azure AD object ID of a given user, which is stable across all Teams channels.
+func teamsUserID(user schema.ChannelAccount) string {
+	if user.AadObjectID != "" {
+		return user.AadObjectID
+	}
+	return user.ID
+}
@@ -3,8 +3,12 @@ package bot
 import (
 	"testing"
 
+	"github.com/infracloudio/msbotbuilder-go/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
 )
 
 func TestTeams_TrimBotMention(t *testing.T) {
@@ -47,3 +51,51 @@ func TestTeams_TrimBotMention(t *testing.T) {
 		})
 	}
 }
+
+func TestTeams_GetConversationRefsToNotify(t *testing.T) {
+	// given
+	b := &Teams{
+		log: loggerx.NewNoop(),
+		channels: teamsChannelsConfigFrom(config.IdentifiableMap[config.ChannelBindingsByName]{
+			"ops": {
+				Name:     "ops",
+				Bindings: config.BotBindings{Sources: []string{"ops-events"}},
+			},
+			"dev": {
+				Name:         "19:dev@thread.tacv2",
+				Notification: config.ChannelNotification{Disabled: true},
+				Bindings:     config.BotBindings{Sources: []string{"dev-events"}},
+			},
+		}),
+		bindings:      config.BotBindings{Sources: []string{"all-events"}},
+		conversations: map[string]conversation{},
+	}
+
+	for _, channel := range []struct{ id, name string }{
+		{id: "19:ops@thread.tacv2", name: "ops"},
+		{id: "19:dev@thread.tacv2"},
+	} {
+		configured, found := b.findChannel(channel.id, channel.name)
+		require.True(t, found)
+		b.registerConversation(schema.ConversationReference{ChannelID: channel.id}, configured.Identifier())
+	}
+	require.NoError(t, b.SetNotificationsEnabled(true, schema.ConversationReference{ChannelID: "msteams"}))
+
+	// when
+	opsRefs := b.getConversationRefsToNotify([]string{"ops-events"})
+	allRefs := b.getConversationRefsToNotify([]string{"all-events"})
+	devRefs := b.getConversationRefsToNotify([]string{"dev-events"})
+
+	// then
+	assert.Equal(t, []schema.ConversationReference{{ChannelID: "19:ops@thread.tacv2"}}, opsRefs)
+	assert.Equal(t, []schema.ConversationReference{{ChannelID: "msteams"}}, allRefs)
+	assert.Empty(t, devRefs)
+
+	// when
+	require.NoError(t, b.SetNotificationsEnabled(true, schema.ConversationReference{ChannelID: "19:dev@thread.tacv2"}))
+	devRefs = b.getConversationRefsToNotify([]string{"dev-events"})
+
+	// then
+	assert.True(t, b.NotificationsEnabled("19:dev@thread.tacv2"))
+	assert.Equal(t, []schema.ConversationReference{{ChannelID: "19:dev@thread.tacv2"}}, devRefs)
+}
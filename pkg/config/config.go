@@ -196,8 +196,33 @@ type ActionBindings struct {
 
 // Sources contains configuration for Botkube app sources.
 type Sources struct {
-	DisplayName string  `yaml:"displayName"`
-	Plugins     Plugins `yaml:",inline" koanf:",remain"`
+	DisplayName string          `yaml:"displayName"`
+	Throttling  EventThrottling `yaml:"throttling,omitempty"`
+	Threading   EventThreading  `yaml:"threading,omitempty"`
+	Plugins     Plugins         `yaml:",inline" koanf:",remain"`
+}
+
+// EventThreading contains configuration for grouping correlated events in threads.
+// When enabled, follow-up events with the same correlation key are posted as thread replies to the first message,
+// and the first message is edited to show the latest status. Supported only by platforms with threads.
+type EventThreading struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+// EventThrottling contains configuration for deduplicating and rate limiting events emitted by a given source.
+type EventThrottling struct {
+	Enabled bool `yaml:"enabled"`
+	// KeyTemplate is a Go template rendered against the raw event (e.g. `{{ .Event.Kind }}/{{ .Event.Name }}`).
+	// Events with the same key are considered similar. If not specified, events are keyed by their kind, namespace, name and reason.
+	// Events without any of these fields are not deduplicated.
+	KeyTemplate string `yaml:"keyTemplate,omitempty"`
+	// Window defines how long similar events are suppressed after the first one was dispatched.
+	// Once the window closes, a single summary message with the number of suppressed events is sent.
+	Window time.Duration `yaml:"window,omitempty"`
+	// MaxMessagesPerMinute limits the number of messages from a given source sent to each bound channel per minute.
+	// Once the limit is renewed, a single summary message with the number of suppressed events is sent to the channel.
+	// Sinks, audit events and actions are not throttled. Zero means no limit.
+	MaxMessagesPerMinute int `yaml:"maxMessagesPerMinute,omitempty" validate:"gte=0"`
 }
 
 // GetPlugins returns Sources.Plugins.
@@ -213,6 +238,87 @@ type Plugin struct {
 	Enabled bool
 	Config  any
 	Context PluginContext
+	// Approval defines commands that must be approved before execution. Supported only for executor plugins.
+	Approval *ApprovalPolicy `yaml:"approval,omitempty"`
+}
+
+// ApprovalPolicy defines which executor commands require approval and who can approve them.
+type ApprovalPolicy struct {
+	// Commands contains commands that require approval, e.g. `kubectl delete`. The first word is matched against the executor name
+	// and the rest against the command arguments in the same order. Flags are ignored, so `kubectl -n foo delete pod` matches `kubectl delete`.
+	Commands []string `yaml:"commands,omitempty"`
+	// CommandRegexes contains regular expressions matched against the whole command, e.g. `^helm (rollback|uninstall)`.
+	CommandRegexes []string `yaml:"commandRegexes,omitempty"`
+	// Approvers contains chat platform user IDs of users allowed to approve commands, e.g. `U04ABCDEF` on Slack.
+	// User mentions are matched as well. Display names are never matched, as they can be changed by users and aren't unique.
+	Approvers []string `yaml:"approvers"`
+	// RequiredApprovals is the number of distinct approvers needed to run a command. Defaults to 1.
+	RequiredApprovals int `yaml:"requiredApprovals,omitempty"`
+	// Timeout after which the approval request expires. Defaults to 10 minutes.
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// RequiresApproval returns true if a given command matches any of the configured commands or regexes.
+func (p *ApprovalPolicy) RequiresApproval(cmd string) (bool, error) {
+	if p == nil {
+		return false, nil
+	}
+
+	args := strings.Fields(cmd)
+	for _, policyCmd := range p.Commands {
+		if matchesCommand(args, strings.Fields(policyCmd)) {
+			return true, nil
+		}
+	}
+
+	cmd = strings.Join(args, " ")
+	for _, expr := range p.CommandRegexes {
+		matched, err := regexp.MatchString(expr, cmd)
+		if err != nil {
+			return false, fmt.Errorf("while matching %q with regex %q: %v", cmd, expr, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// matchesCommand returns true if the first argument is the policy executor name, and the rest of policy words
+// are found in the same order among arguments which are not flags. Flag values cannot be told apart from
+// positional arguments without knowing the executor flags, so they are taken into account as well.
+// As a result, the policy can match more commands than expected, but it cannot be bypassed by placing flags before the verb.
+func matchesCommand(args, policy []string) bool {
+	if len(policy) == 0 || len(args) == 0 || args[0] != policy[0] {
+		return false
+	}
+
+	policy = policy[1:]
+	for _, arg := range args[1:] {
+		if len(policy) == 0 {
+			break
+		}
+		if strings.HasPrefix(arg, "-") {
+			continue
+		}
+		if arg == policy[0] {
+			policy = policy[1:]
+		}
+	}
+	return len(policy) == 0
+}
+
+// IsApprover returns true if a given user is allowed to approve commands. Only user IDs and mentions should be passed.
+func (p *ApprovalPolicy) IsApprover(userIDs ...string) bool {
+	for _, approver := range p.Approvers {
+		for _, id := range userIDs {
+			if id != "" && approver == id {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // PluginContext defines the context for given plugin.
@@ -237,6 +343,14 @@ type GroupPolicySubject struct {
 	Static GroupStaticSubject `yaml:"static"`
 	// Prefix is optional string prefixed to subjects.
 	Prefix string `yaml:"prefix"`
+	// PlatformUserGroup configures the PlatformUserGroup policy subject.
+	PlatformUserGroup PlatformUserGroupSubject `yaml:"platformUserGroup,omitempty"`
+}
+
+// PlatformUserGroupSubject maps groups of the chat user, such as Discord roles or Mattermost roles, to Kubernetes groups.
+type PlatformUserGroupSubject struct {
+	// Mapping maps chat platform groups to Kubernetes groups. Mapped groups are not prefixed.
+	Mapping map[string]string `yaml:"mapping,omitempty"`
 }
 
 // GroupStaticSubject references static subjects for given static policy rule.
@@ -253,8 +367,28 @@ type UserPolicySubject struct {
 	Static UserStaticSubject `yaml:"static"`
 	// Prefix is optional string prefixed to subjects.
 	Prefix string `yaml:"prefix"`
+	// PlatformUser configures the PlatformUser policy subject.
+	PlatformUser PlatformUserSubject `yaml:"platformUser,omitempty"`
+}
+
+// PlatformUserSubject defines how the chat user is mapped to a Kubernetes user.
+type PlatformUserSubject struct {
+	// Attribute of the chat user used as the Kubernetes user name. Defaults to ID.
+	Attribute PlatformUserAttribute `yaml:"attribute,omitempty"`
+	// Mapping maps the chat user attribute values to Kubernetes users. Mapped users are not prefixed.
+	Mapping map[string]string `yaml:"mapping,omitempty"`
 }
 
+// PlatformUserAttribute defines the chat user attribute used to derive the Kubernetes user.
+type PlatformUserAttribute string
+
+const (
+	// PlatformUserIDAttribute uses the chat user ID.
+	PlatformUserIDAttribute PlatformUserAttribute = "ID"
+	// PlatformUserEmailAttribute uses the chat user email. It's available only on platforms exposing it, such as Mattermost.
+	PlatformUserEmailAttribute PlatformUserAttribute = "Email"
+)
+
 // UserStaticSubject references static subjects for given static policy rule.
 type UserStaticSubject struct {
 	// Value is the name of the subject.
@@ -271,8 +405,20 @@ const (
 	StaticPolicySubjectType PolicySubjectType = "Static"
 	// ChannelNamePolicySubjectType is the channel name policy type.
 	ChannelNamePolicySubjectType PolicySubjectType = "ChannelName"
+	// PlatformUserPolicySubjectType is the policy type that impersonates the chat user who executes a command.
+	PlatformUserPolicySubjectType PolicySubjectType = "PlatformUser"
+	// PlatformUserGroupPolicySubjectType is the policy type that impersonates groups of the chat user who executes a command.
+	PlatformUserGroupPolicySubjectType PolicySubjectType = "PlatformUserGroup"
 )
 
+// IsUserSpecific returns true if the policy rule requires details about the chat user who executes a command.
+func (r *PolicyRule) IsUserSpecific() bool {
+	if r == nil {
+		return false
+	}
+	return r.User.Type == PlatformUserPolicySubjectType || r.Group.Type == PlatformUserGroupPolicySubjectType
+}
+
 // Executors contains executors configuration parameters.
 type Executors struct {
 	Plugins Plugins `yaml:",inline" koanf:",remain"`
@@ -471,9 +617,10 @@ type Teams struct {
 	AppPassword string `yaml:"appPassword,omitempty"`
 	Port        string `yaml:"port"`
 	MessagePath string `yaml:"messagePath,omitempty"`
-	// TODO: Be consistent with other communicators when MS Teams support multiple channels
-	//Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"`
-	Bindings BotBindings `yaml:"bindings" validate:"required_if=Enabled true"`
+	// Channels holds configuration for MS Teams channels. The channel name is matched against the Teams channel name or ID.
+	Channels IdentifiableMap[ChannelBindingsByName] `yaml:"channels,omitempty"  validate:"dive,omitempty,min=1"`
+	// Bindings are used for all conversations that are not defined under Channels.
+	Bindings BotBindings `yaml:"bindings"`
 }
 
 // Discord configuration for authentication and send notifications
@@ -508,17 +655,119 @@ type RemoteCfgWatcher struct {
 
 // Settings contains Botkube's related configuration.
 type Settings struct {
-	ClusterName             string           `yaml:"clusterName"`
-	UpgradeNotifier         bool             `yaml:"upgradeNotifier"`
-	SystemConfigMap         K8sResourceRef   `yaml:"systemConfigMap"`
-	PersistentConfig        PersistentConfig `yaml:"persistentConfig"`
-	MetricsPort             string           `yaml:"metricsPort"`
-	HealthPort              string           `yaml:"healthPort"`
-	LifecycleServer         LifecycleServer  `yaml:"lifecycleServer"`
-	Log                     Logger           `yaml:"log"`
-	InformersResyncPeriod   time.Duration    `yaml:"informersResyncPeriod"`
-	Kubeconfig              string           `yaml:"kubeconfig"`
-	SACredentialsPathPrefix string           `yaml:"saCredentialsPathPrefix"`
+	ClusterName             string              `yaml:"clusterName"`
+	UpgradeNotifier         bool                `yaml:"upgradeNotifier"`
+	SystemConfigMap         K8sResourceRef      `yaml:"systemConfigMap"`
+	PersistentConfig        PersistentConfig    `yaml:"persistentConfig"`
+	MetricsPort             string              `yaml:"metricsPort"`
+	HealthPort              string              `yaml:"healthPort"`
+	LifecycleServer         LifecycleServer     `yaml:"lifecycleServer"`
+	Log                     Logger              `yaml:"log"`
+	InformersResyncPeriod   time.Duration       `yaml:"informersResyncPeriod"`
+	Kubeconfig              string              `yaml:"kubeconfig"`
+	SACredentialsPathPrefix string              `yaml:"saCredentialsPathPrefix"`
+	DeliveryRetry           DeliveryRetry       `yaml:"deliveryRetry"`
+	Audit                   Audit               `yaml:"audit"`
+	LeaderElection          LeaderElection      `yaml:"leaderElection"`
+	OutputPagination        OutputPagination    `yaml:"outputPagination"`
+	NotificationActions     NotificationActions `yaml:"notificationActions"`
+}
+
+// NotificationActions contains configuration for acknowledging, snoozing and silencing source notifications.
+type NotificationActions struct {
+	// Silencers contains chat platform user IDs of users allowed to snooze, silence and unsilence notifications, e.g. `U04ABCDEF` on Slack.
+	// User mentions are matched as well. If empty, notifications cannot be snoozed or silenced. Everyone can acknowledge notifications.
+	Silencers []string `yaml:"silencers"`
+}
+
+// IsSilencer returns true if a given user is allowed to snooze and silence notifications. Only user IDs and mentions should be passed.
+func (n NotificationActions) IsSilencer(userIDs ...string) bool {
+	for _, silencer := range n.Silencers {
+		for _, id := range userIDs {
+			if id != "" && silencer == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// OutputPagination contains configuration for splitting long executor outputs into pages on interactive platforms.
+type OutputPagination struct {
+	Enabled bool `yaml:"enabled"`
+	// PageSize is the max number of lines displayed on a single page.
+	PageSize int `yaml:"pageSize" validate:"required_if=Enabled true,gte=0"`
+	// MaxPages is the max number of pages. Longer outputs are sent as a file attachment.
+	MaxPages int `yaml:"maxPages" validate:"gte=0"`
+	// CacheTTL defines how long the full output is kept to navigate between pages.
+	CacheTTL time.Duration `yaml:"cacheTTL" validate:"required_if=Enabled true"`
+}
+
+// LeaderElection contains configuration for running multiple Botkube replicas.
+// Only the leader replica runs bots, sources and notifiers, while standby replicas keep plugins ready for a failover.
+type LeaderElection struct {
+	Enabled bool           `yaml:"enabled"`
+	Lease   K8sResourceRef `yaml:"lease"`
+	// Identity of a given replica. If not specified, the hostname is used.
+	Identity string `yaml:"identity"`
+	// LeaseDuration is the time after which a standby replica can take over the leadership if the leader stops renewing the lease.
+	LeaseDuration time.Duration `yaml:"leaseDuration" validate:"required_if=Enabled true"`
+	RenewDeadline time.Duration `yaml:"renewDeadline" validate:"required_if=Enabled true,ltfield=LeaseDuration"`
+	RetryPeriod   time.Duration `yaml:"retryPeriod" validate:"required_if=Enabled true"`
+}
+
+// AuditOutputType defines where the local audit log is written.
+type AuditOutputType string
+
+const (
+	// StdoutAuditOutputType writes audit events to the standard output.
+	StdoutAuditOutputType AuditOutputType = "stdout"
+	// FileAuditOutputType writes audit events to a rotated file.
+	FileAuditOutputType AuditOutputType = "file"
+	// WebhookAuditOutputType posts audit events to a given URL.
+	WebhookAuditOutputType AuditOutputType = "webhook"
+)
+
+// Audit contains configuration for the local audit log. It is independent of the Botkube Cloud audit events.
+type Audit struct {
+	Enabled bool            `yaml:"enabled"`
+	Type    AuditOutputType `yaml:"type" validate:"required_if=Enabled true,omitempty,oneof=stdout file webhook"`
+	File    AuditFile       `yaml:"file"`
+	Webhook AuditWebhook    `yaml:"webhook"`
+}
+
+// AuditFile contains configuration for the file audit log output.
+type AuditFile struct {
+	Path       string `yaml:"path"`
+	MaxSizeMB  int    `yaml:"maxSizeMB"`
+	MaxBackups int    `yaml:"maxBackups"`
+	MaxAgeDays int    `yaml:"maxAgeDays"`
+	Compress   bool   `yaml:"compress"`
+}
+
+// AuditWebhook contains configuration for the webhook audit log output.
+type AuditWebhook struct {
+	URL string `yaml:"url"`
+}
+
+// DeliveryRetry contains configuration for retrying failed deliveries of notifications.
+type DeliveryRetry struct {
+	Enabled bool `yaml:"enabled"`
+	// QueueSize is the max number of pending deliveries per notifier. Deliveries which don't fit into the queue are moved to the dead letter store.
+	// Failed deliveries wait for the next attempt in a separate queue of the same size, so they don't block new deliveries.
+	QueueSize      int           `yaml:"queueSize" validate:"required_if=Enabled true,gte=0"`
+	MaxAttempts    int           `yaml:"maxAttempts" validate:"required_if=Enabled true,gte=0"`
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	MaxBackoff     time.Duration `yaml:"maxBackoff"`
+	DeadLetter     DeadLetter    `yaml:"deadLetter"`
+}
+
+// DeadLetter contains configuration for storing notifications that couldn't be delivered.
+// If neither FileName nor ConfigMap is specified, failed deliveries are kept in memory.
+type DeadLetter struct {
+	FileName  string         `yaml:"fileName"`
+	ConfigMap K8sResourceRef `yaml:"configMap"`
+	MaxItems  int            `yaml:"maxItems"`
 }
 
 // Formatter log formatter
@@ -219,6 +219,27 @@ func TestLoadedConfigValidationErrors(t *testing.T) {
 				readTestdataFile(t, "sources-rbac.yaml"),
 			},
 		},
+		{
+			name: "User-specific RBAC for sources",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 1 error occurred:
+					* Key: 'Config.Sources[cm].RBAC' Plugin botkube/cm-watcher has user-specific RBAC policy. 'PlatformUser' and 'PlatformUserGroup' subjects are not supported for sources.`),
+			configs: [][]byte{
+				readTestdataFile(t, "user-specific-rbac.yaml"),
+			},
+		},
+		{
+			name: "invalid approval policy",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 4 errors occurred:
+					* Key: 'Config.Executors[tools].CommandRegexes' botkube/helm approval policy has invalid regex "^helm (rollback|uninstall": error parsing regexp: missing closing ): ` + "`^helm (rollback|uninstall`" + `
+					* Key: 'Config.Executors[tools].RequiredApprovals' botkube/helm approval policy requires 2 approvals but only 1 approvers are defined
+					* Key: 'Config.Executors[tools].Approval' botkube/kubectl approval policy must define at least one command prefix or regex
+					* Key: 'Config.Executors[tools].Approvers' botkube/kubectl approval policy must define at least one approver`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-approval-policy.yaml"),
+			},
+		},
 		{
 			name: "Invalid channel names",
 			expErrMsg: heredoc.Doc(`
@@ -432,3 +453,39 @@ func TestRegexConstraints_IsAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestApprovalPolicyRequiresApproval(t *testing.T) {
+	// given
+	policy := &config.ApprovalPolicy{
+		Commands:       []string{"kubectl delete", "helm  uninstall"},
+		CommandRegexes: []string{`^helm rollback .* --force`},
+	}
+
+	tests := []struct {
+		cmd      string
+		expected bool
+	}{
+		{cmd: "kubectl delete pod nginx", expected: true},
+		{cmd: "kubectl   delete", expected: true},
+		{cmd: "kubectl -n default delete pod nginx", expected: true},
+		{cmd: "kubectl --namespace=default delete pod nginx", expected: true},
+		{cmd: "kubectl get pods --selector=delete", expected: false},
+		{cmd: "kubectl-delete", expected: false},
+		{cmd: "kubectl deletecollection", expected: false},
+		{cmd: "kubectl get pods", expected: false},
+		{cmd: "helm uninstall nginx", expected: true},
+		{cmd: "helm --namespace default uninstall nginx", expected: true},
+		{cmd: "helm rollback nginx 1 --force", expected: true},
+		{cmd: "helm rollback nginx 1", expected: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.cmd, func(t *testing.T) {
+			// when
+			got, err := policy.RequiresApproval(tc.cmd)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -24,6 +24,7 @@ var (
 		SocketSlackCommPlatformIntegration: {},
 		DiscordCommPlatformIntegration:     {},
 		MattermostCommPlatformIntegration:  {},
+		TeamsCommPlatformIntegration:       {},
 	}
 )
 
@@ -45,7 +45,8 @@ func (m *K8sConfigPersistenceManager) PersistSourceBindings(ctx context.Context,
 		state.Communications[commGroupName][platform] = platformCfg
 	}
 
-	if platform == TeamsCommPlatformIntegration {
+	// MS Teams conversations which are not defined in the configuration share the same bindings.
+	if platform == TeamsCommPlatformIntegration && channelAlias == "" {
 		if platformCfg.MSTeamsOnlyRuntimeState == nil {
 			platformCfg.MSTeamsOnlyRuntimeState = &ChannelRuntimeState{}
 		}
@@ -88,6 +89,10 @@ func (m *K8sConfigPersistenceManager) PersistNotificationsEnabled(ctx context.Co
 	if _, ok := supportedPlatformsNotifications[platform]; !ok {
 		return ErrUnsupportedPlatform
 	}
+	// MS Teams conversations which are not defined in the configuration are registered dynamically.
+	if platform == TeamsCommPlatformIntegration && channelAlias == "" {
+		return ErrUnsupportedPlatform
+	}
 
 	cmStorage := configMapStorage[StartupState]{k8sCli: m.k8sCli, cfg: m.cfg.Startup}
 	state, cm, err := cmStorage.Get(ctx)
@@ -73,7 +73,7 @@ func TestPersistenceManager_PersistSourceBindings(t *testing.T) {
 		{
 			Name:                "Empty state files - MS Teams",
 			InputPlatform:       config.TeamsCommPlatformIntegration,
-			InputChannel:        "foo",
+			InputChannel:        "",
 			InputSourceBindings: []string{"first", "second"},
 			InputCfgMap: &v1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
@@ -102,6 +102,40 @@ func TestPersistenceManager_PersistSourceBindings(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:                "Empty state files - MS Teams channel",
+			InputPlatform:       config.TeamsCommPlatformIntegration,
+			InputChannel:        "ops",
+			InputSourceBindings: []string{"first", "second"},
+			InputCfgMap: &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cfg.ConfigMap.Name,
+					Namespace: cfg.ConfigMap.Namespace,
+				},
+				Data: map[string]string{
+					cfg.FileName: "",
+				},
+			},
+			Expected: &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cfg.ConfigMap.Name,
+					Namespace: cfg.ConfigMap.Namespace,
+				},
+				Data: map[string]string{
+					cfg.FileName: heredoc.Doc(`
+                      communications:
+                        default-group:
+                          teams:
+                            channels:
+                              ops:
+                                bindings:
+                                  sources:
+                                    - first
+                                    - second
+					`),
+				},
+			},
+		},
 		{
 			Name:                "Existing state files",
 			InputChannel:        "general",
@@ -159,7 +193,7 @@ func TestPersistenceManager_PersistSourceBindings(t *testing.T) {
 		},
 		{
 			Name:                "Existing state files - MS Teams",
-			InputChannel:        "anything",
+			InputChannel:        "",
 			InputPlatform:       config.TeamsCommPlatformIntegration,
 			InputSourceBindings: []string{"new", "newer"},
 			InputCfgMap: &v1.ConfigMap{
@@ -370,9 +404,9 @@ func TestPersistenceManager_PersistNotificationsEnabled(t *testing.T) {
 			},
 		},
 		{
-			Name:          "Unsupported platform",
+			Name:          "Unsupported platform - MS Teams conversation not defined in config",
 			InputPlatform: config.TeamsCommPlatformIntegration,
-			InputChannel:  "foo",
+			InputChannel:  "",
 			InputEnabled:  false,
 			InputCfgMap: &v1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
@@ -45,6 +45,9 @@ func (m *RemotePersistenceManager) PersistNotificationsEnabled(ctx context.Conte
 	if _, ok := supportedPlatformsSourceBindings[platform]; !ok {
 		return ErrUnsupportedPlatform
 	}
+	if platform == TeamsCommPlatformIntegration && channelAlias == "" {
+		return ErrUnsupportedPlatform
+	}
 
 	p := remoteapi.NewBotPlatform(platform.String())
 	if p == nil {
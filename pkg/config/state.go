@@ -49,6 +49,7 @@ type BotRuntimeState struct {
 	Channels map[string]ChannelRuntimeState `yaml:"channels,omitempty"`
 
 	// Teams integration only, ignored for other communication platforms.
+	// Holds state of conversations which are not defined under Teams channels.
 	MSTeamsOnlyRuntimeState *ChannelRuntimeState `yaml:",inline,omitempty"`
 }
 
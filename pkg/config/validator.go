@@ -14,6 +14,7 @@ import (
 
 	"github.com/kubeshop/botkube/pkg/conversation"
 	"github.com/kubeshop/botkube/pkg/execute/command"
+	"github.com/kubeshop/botkube/pkg/maputil"
 	multierrx "github.com/kubeshop/botkube/pkg/multierror"
 )
 
@@ -28,6 +29,8 @@ const (
 	invalidAliasCommandTag      = "invalid_alias_command"
 	invalidPluginRBACTag        = "invalid_plugin_rbac"
 	invalidActionRBACTag        = "invalid_action_tag"
+	invalidApprovalPolicyTag    = "invalid_approval_policy"
+	invalidSourceRBACTag        = "invalid_source_rbac"
 	appTokenPrefix              = "xapp-"
 	botTokenPrefix              = "xoxb-"
 )
@@ -88,6 +91,7 @@ func ValidateStruct(in any) (ValidateResult, error) {
 	validate.RegisterStructValidation(discordValidator, Discord{})
 	validate.RegisterStructValidation(cloudSlackValidator, CloudSlack{})
 	validate.RegisterStructValidation(mattermostValidator, Mattermost{})
+	validate.RegisterStructValidation(teamsValidator, Teams{})
 
 	validate.RegisterStructValidation(sourceStructValidator, Sources{})
 	validate.RegisterStructValidation(executorStructValidator, Executors{})
@@ -146,8 +150,10 @@ func registerBindingsValidator(validate *validator.Validate, trans ut.Translator
 		conflictingPluginRepoTag:    "{0}{1}",
 		conflictingPluginVersionTag: "{0}{1}",
 		invalidPluginDefinitionTag:  "{0}{1}",
+		invalidApprovalPolicyTag:    "{0} approval policy {1}",
+		invalidSourceRBACTag:        "Plugin {0} has user-specific RBAC policy. 'PlatformUser' and 'PlatformUserGroup' subjects are not supported for sources.",
 		invalidPluginRBACTag:        "Binding is referencing plugins of same kind with different RBAC. '{0}' and '{1}' bindings must be identical when used together.",
-		invalidActionRBACTag:        "Plugin {0} has 'ChannelName' RBAC policy. This is not supported for actions. See https://docs.botkube.io/configuration/action#rbac",
+		invalidActionRBACTag:        "Plugin {0} has '{1}' RBAC policy. This is not supported for actions. See https://docs.botkube.io/configuration/action#rbac",
 	})
 }
 
@@ -235,6 +241,24 @@ func mattermostValidator(sl validator.StructLevel) {
 	validateChannels(sl, mattermostChannelNameRegex, false, mattermost.Channels, "Name", mattermostDocsURL)
 }
 
+func teamsValidator(sl validator.StructLevel) {
+	teams, ok := sl.Current().Interface().(Teams)
+
+	if !ok || !teams.Enabled {
+		return
+	}
+
+	if len(teams.Channels) == 0 && len(teams.Bindings.Sources) == 0 && len(teams.Bindings.Executors) == 0 {
+		sl.ReportError(teams.Channels, "Channels", "Channels", "required", "")
+	}
+
+	for _, channelAlias := range maputil.SortKeys(teams.Channels) {
+		if teams.Channels[channelAlias].Identifier() == "" {
+			sl.ReportError(teams.Channels[channelAlias].Name, channelAlias, "Name", "required", "")
+		}
+	}
+}
+
 func validateChannels[T Identifiable](sl validator.StructLevel, regex *regexp.Regexp, shouldNormalize bool, channels IdentifiableMap[T], fieldName, docsURL string) {
 	if len(channels) == 0 {
 		sl.ReportError(channels, "Channels", "Channels", "required", "")
@@ -289,6 +313,17 @@ func sourceStructValidator(sl validator.StructLevel) {
 	}
 
 	validatePlugins(sl, sources.Plugins)
+	validateSourceRBAC(sl, sources.Plugins)
+}
+
+func validateSourceRBAC(sl validator.StructLevel, plugins Plugins) {
+	for _, pluginKey := range maputil.SortKeys(plugins) {
+		plugin := plugins[pluginKey]
+		if !plugin.Enabled || !plugin.Context.RBAC.IsUserSpecific() {
+			continue
+		}
+		sl.ReportError(plugin.Context.RBAC, pluginKey, "RBAC", invalidSourceRBACTag, "")
+	}
 }
 
 func executorStructValidator(sl validator.StructLevel) {
@@ -298,6 +333,32 @@ func executorStructValidator(sl validator.StructLevel) {
 	}
 
 	validatePlugins(sl, executor.Plugins)
+	validateApprovalPolicies(sl, executor.Plugins)
+}
+
+func validateApprovalPolicies(sl validator.StructLevel, plugins Plugins) {
+	for _, pluginKey := range maputil.SortKeys(plugins) {
+		policy := plugins[pluginKey].Approval
+		if policy == nil {
+			continue
+		}
+
+		if len(policy.Commands) == 0 && len(policy.CommandRegexes) == 0 {
+			sl.ReportError(policy, pluginKey, "Approval", invalidApprovalPolicyTag, "must define at least one command prefix or regex")
+		}
+		for _, expr := range policy.CommandRegexes {
+			if _, err := regexp.Compile(expr); err != nil {
+				sl.ReportError(policy.CommandRegexes, pluginKey, "CommandRegexes", invalidApprovalPolicyTag, fmt.Sprintf("has invalid regex %q: %s", expr, err.Error()))
+			}
+		}
+		if len(policy.Approvers) == 0 {
+			sl.ReportError(policy.Approvers, pluginKey, "Approvers", invalidApprovalPolicyTag, "must define at least one approver")
+		}
+		if policy.RequiredApprovals > len(policy.Approvers) {
+			msg := fmt.Sprintf("requires %d approvals but only %d approvers are defined", policy.RequiredApprovals, len(policy.Approvers))
+			sl.ReportError(policy.RequiredApprovals, pluginKey, "RequiredApprovals", invalidApprovalPolicyTag, msg)
+		}
+	}
 }
 
 func botBindingsStructValidator(sl validator.StructLevel) {
@@ -450,8 +511,13 @@ func validateActionExecutors(sl validator.StructLevel, executors map[string]Exec
 			if plugin.Context.RBAC == nil {
 				continue
 			}
-			if plugin.Context.RBAC.Group.Type == ChannelNamePolicySubjectType {
-				sl.ReportError(bindings, pluginKey, executor, invalidActionRBACTag, "")
+			switch {
+			case plugin.Context.RBAC.Group.Type == ChannelNamePolicySubjectType:
+				sl.ReportError(bindings, pluginKey, executor, invalidActionRBACTag, string(ChannelNamePolicySubjectType))
+			case plugin.Context.RBAC.User.Type == PlatformUserPolicySubjectType:
+				sl.ReportError(bindings, pluginKey, executor, invalidActionRBACTag, string(PlatformUserPolicySubjectType))
+			case plugin.Context.RBAC.Group.Type == PlatformUserGroupPolicySubjectType:
+				sl.ReportError(bindings, pluginKey, executor, invalidActionRBACTag, string(PlatformUserGroupPolicySubjectType))
 			}
 		}
 	}
@@ -0,0 +1,327 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/metrics"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+const (
+	defaultApprovalTimeout = 10 * time.Minute
+	approvalIDLength       = 8
+)
+
+var (
+	approvalRequestFeatureName = FeatureName{Name: "request", Aliases: []string{"requests", "req"}}
+)
+
+// ApprovalExecutor holds commands that require approval and executes them once approved.
+type ApprovalExecutor struct {
+	log            logrus.FieldLogger
+	pluginExecutor *PluginExecutor
+	auditReporter  audit.AuditReporter
+
+	mu       sync.Mutex
+	requests map[string]*approvalRequest
+	now      func() time.Time
+}
+
+type approvalRequest struct {
+	id         string
+	policy     config.ApprovalPolicy
+	pluginName string
+	cmdCtx     CommandContext
+	approvedBy []string
+	// approverIDs holds stable identifiers of users who approved the request.
+	approverIDs []string
+	expiresAt   time.Time
+}
+
+// NewApprovalExecutor returns a new ApprovalExecutor instance.
+func NewApprovalExecutor(log logrus.FieldLogger, pluginExecutor *PluginExecutor, auditReporter audit.AuditReporter) *ApprovalExecutor {
+	return &ApprovalExecutor{
+		log:            log,
+		pluginExecutor: pluginExecutor,
+		auditReporter:  auditReporter,
+		requests:       map[string]*approvalRequest{},
+		now:            time.Now,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor
+func (e *ApprovalExecutor) FeatureName() FeatureName {
+	return approvalRequestFeatureName
+}
+
+// Commands returns slice of commands the executor supports
+func (e *ApprovalExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ApproveVerb: e.Approve,
+		command.DenyVerb:    e.Deny,
+		command.ListVerb:    e.List,
+	}
+}
+
+// RequestApproval holds a given plugin command until it's approved by the required number of approvers.
+func (e *ApprovalExecutor) RequestApproval(ctx context.Context, policy config.ApprovalPolicy, pluginName string, cmdCtx CommandContext) interactive.CoreMessage {
+	if policy.RequiredApprovals < 1 {
+		policy.RequiredApprovals = 1
+	}
+	if policy.Timeout <= 0 {
+		policy.Timeout = defaultApprovalTimeout
+	}
+
+	req := &approvalRequest{
+		id:         uuid.NewString()[:approvalIDLength],
+		policy:     policy,
+		pluginName: pluginName,
+		cmdCtx:     cmdCtx,
+		expiresAt:  e.now().Add(policy.Timeout),
+	}
+
+	e.mu.Lock()
+	expired := e.removeExpired()
+	e.requests[req.id] = req
+	e.mu.Unlock()
+
+	for _, item := range expired {
+		e.reportAudit(ctx, item, item.cmdCtx, "expired")
+	}
+
+	e.log.WithFields(logrus.Fields{
+		"id":      req.id,
+		"command": cmdCtx.CleanCmd,
+		"user":    cmdCtx.User.DisplayName,
+	}).Info("Command requires approval")
+	e.reportAudit(ctx, req, cmdCtx, "requested")
+
+	return approvalMessage(req, cmdCtx.Platform.IsInteractive())
+}
+
+// Approve records approval for a given request. Once the required number of approvals is collected, the command is executed.
+func (e *ApprovalExecutor) Approve(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	req, msg, ok := e.getRequestForApprover(ctx, cmdCtx)
+	if !ok {
+		return msg, nil
+	}
+
+	e.mu.Lock()
+	if _, found := e.requests[req.id]; !found {
+		e.mu.Unlock()
+		return respond(fmt.Sprintf("Approval request %q was already processed.", req.id), cmdCtx), nil
+	}
+	approverID := approvalUserID(cmdCtx.User)
+	for _, id := range req.approverIDs {
+		if id == approverID {
+			e.mu.Unlock()
+			return respond(fmt.Sprintf("You have already approved the %q request.", req.id), cmdCtx), nil
+		}
+	}
+	req.approvedBy = append(req.approvedBy, userName(cmdCtx.User))
+	req.approverIDs = append(req.approverIDs, approverID)
+	isApproved := len(req.approvedBy) >= req.policy.RequiredApprovals
+	if isApproved {
+		delete(e.requests, req.id)
+	}
+	e.mu.Unlock()
+
+	e.reportAudit(ctx, req, cmdCtx, fmt.Sprintf("approved %d/%d", len(req.approvedBy), req.policy.RequiredApprovals))
+	if !isApproved {
+		return approvalMessage(req, cmdCtx.Platform.IsInteractive()), nil
+	}
+
+	return e.execute(ctx, req)
+}
+
+// Deny rejects a given request.
+func (e *ApprovalExecutor) Deny(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	req, msg, ok := e.getRequestForApprover(ctx, cmdCtx)
+	if !ok {
+		return msg, nil
+	}
+
+	e.mu.Lock()
+	delete(e.requests, req.id)
+	e.mu.Unlock()
+
+	e.reportAudit(ctx, req, cmdCtx, "denied")
+	return respond(fmt.Sprintf("Command `%s` requested by %s was denied by %s.", req.cmdCtx.CleanCmd, userName(req.cmdCtx.User), userName(cmdCtx.User)), cmdCtx), nil
+}
+
+// List returns a tabular representation of pending approval requests.
+func (e *ApprovalExecutor) List(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	e.mu.Lock()
+	expired := e.removeExpired()
+	var pending []approvalRequest
+	for _, req := range e.requests {
+		pending = append(pending, *req)
+	}
+	e.mu.Unlock()
+
+	for _, item := range expired {
+		e.reportAudit(ctx, item, item.cmdCtx, "expired")
+	}
+
+	if len(pending) == 0 {
+		return respond("There are no pending approval requests.", cmdCtx), nil
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].expiresAt.Before(pending[j].expiresAt)
+	})
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "ID\tCOMMAND\tREQUESTED BY\tAPPROVALS\tEXPIRES IN")
+	for _, req := range pending {
+		expiresIn := req.expiresAt.Sub(e.now()).Round(time.Second)
+		fmt.Fprintf(w, "\n%s\t%s\t%s\t%d/%d\t%s", req.id, req.cmdCtx.CleanCmd, userName(req.cmdCtx.User), len(req.approvedBy), req.policy.RequiredApprovals, expiresIn)
+	}
+	w.Flush()
+
+	return respond(buf.String(), cmdCtx), nil
+}
+
+func (e *ApprovalExecutor) getRequestForApprover(ctx context.Context, cmdCtx CommandContext) (*approvalRequest, interactive.CoreMessage, bool) {
+	if len(cmdCtx.Args) < 3 {
+		return nil, respond(incompleteCmdMsg, cmdCtx), false
+	}
+	id := cmdCtx.Args[2]
+
+	e.mu.Lock()
+	req, found := e.requests[id]
+	isExpired := found && e.now().After(req.expiresAt)
+	if isExpired {
+		delete(e.requests, id)
+	}
+	e.mu.Unlock()
+
+	switch {
+	case !found:
+		return nil, respond(fmt.Sprintf("Approval request %q not found. It was already processed or it has expired.", id), cmdCtx), false
+	case isExpired:
+		e.reportAudit(ctx, req, cmdCtx, "expired")
+		return nil, respond(fmt.Sprintf("Approval request %q has expired.", id), cmdCtx), false
+	case !req.policy.IsApprover(cmdCtx.User.ID, cmdCtx.User.Mention):
+		return nil, respond(fmt.Sprintf("You are not allowed to process the %q request.", id), cmdCtx), false
+	case approvalUserID(cmdCtx.User) == approvalUserID(req.cmdCtx.User):
+		return nil, respond(fmt.Sprintf("You cannot process your own %q request.", id), cmdCtx), false
+	}
+
+	return req, interactive.CoreMessage{}, true
+}
+
+func (e *ApprovalExecutor) execute(ctx context.Context, req *approvalRequest) (interactive.CoreMessage, error) {
+	cmdCtx := req.cmdCtx
+	if err := e.auditReporter.ReportExecutorAuditEvent(ctx, newExecutorAuditEvent(req.pluginName, cmdCtx.ExpandedRawCmd, cmdCtx)); err != nil {
+		e.log.Errorf("while reporting executor audit event for %q: %s", req.id, err.Error())
+	}
+
+	start := time.Now()
+	out, err := e.pluginExecutor.Execute(ctx, cmdCtx.Conversation.ExecutorBindings, nil, cmdCtx)
+	metrics.ObserveExecutorCommand(req.pluginName, time.Since(start), err)
+	switch {
+	case err == nil:
+	case IsExecutionCommandError(err):
+		return respond(err.Error(), cmdCtx), nil
+	default:
+		return interactive.CoreMessage{}, fmt.Errorf("while executing approved command %q: %w", req.id, err)
+	}
+
+	if out.Description != "" {
+		out.Description = fmt.Sprintf("%s (approved by %s)", out.Description, strings.Join(req.approvedBy, ", "))
+	}
+	return out, nil
+}
+
+// removeExpired removes and returns all expired requests. Caller must hold the lock.
+func (e *ApprovalExecutor) removeExpired() []*approvalRequest {
+	var out []*approvalRequest
+	now := e.now()
+	for id, req := range e.requests {
+		if now.After(req.expiresAt) {
+			out = append(out, req)
+			delete(e.requests, id)
+		}
+	}
+	return out
+}
+
+func (e *ApprovalExecutor) reportAudit(ctx context.Context, req *approvalRequest, cmdCtx CommandContext, action string) {
+	cmd := fmt.Sprintf("%s [approval %s %s]", req.cmdCtx.ExpandedRawCmd, req.id, action)
+	if err := e.auditReporter.ReportExecutorAuditEvent(ctx, newExecutorAuditEvent(req.pluginName, cmd, cmdCtx)); err != nil {
+		e.log.Errorf("while reporting approval audit event for %q: %s", req.id, err.Error())
+	}
+}
+
+func approvalMessage(req *approvalRequest, isInteractive bool) interactive.CoreMessage {
+	cmdCtx := req.cmdCtx
+	approveCmd := fmt.Sprintf("%s %s %s", command.ApproveVerb, approvalRequestFeatureName.Name, req.id)
+	denyCmd := fmt.Sprintf("%s %s %s", command.DenyVerb, approvalRequestFeatureName.Name, req.id)
+
+	desc := fmt.Sprintf("Command requested by %s requires %d approval(s) from: %s. The request expires at %s.",
+		userName(cmdCtx.User), req.policy.RequiredApprovals, strings.Join(req.policy.Approvers, ", "), req.expiresAt.UTC().Format(time.RFC1123))
+	if len(req.approvedBy) > 0 {
+		desc = fmt.Sprintf("%s Already approved by: %s.", desc, strings.Join(req.approvedBy, ", "))
+	}
+
+	section := api.Section{
+		Base: api.Base{
+			Header:      fmt.Sprintf(":lock: Approval required (%s)", req.id),
+			Description: desc,
+			Body: api.Body{
+				CodeBlock: cmdCtx.CleanCmd,
+			},
+		},
+	}
+
+	btns := api.NewMessageButtonBuilder()
+	if isInteractive {
+		section.Buttons = api.Buttons{
+			btns.ForCommandWithoutDesc("Approve", approveCmd, api.ButtonStylePrimary),
+			btns.ForCommandWithoutDesc("Deny", denyCmd, api.ButtonStyleDanger),
+		}
+	} else {
+		section.Context = api.ContextItems{
+			{Text: fmt.Sprintf("To approve, run: %s %s", api.MessageBotNamePlaceholder, approveCmd)},
+			{Text: fmt.Sprintf("To deny, run: %s %s", api.MessageBotNamePlaceholder, denyCmd)},
+		}
+	}
+
+	return interactive.CoreMessage{
+		Description: header(cmdCtx),
+		Message: api.Message{
+			Sections: []api.Section{section},
+		},
+	}
+}
+
+// approvalUserID returns a stable user identifier. Display names are not used, as they can be changed by users and aren't unique.
+func approvalUserID(user UserInput) string {
+	if user.ID != "" {
+		return user.ID
+	}
+	return user.Mention
+}
+
+func userName(user UserInput) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	return user.Mention
+}
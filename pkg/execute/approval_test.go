@@ -0,0 +1,146 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestApprovalExecutorDenyFlow(t *testing.T) {
+	// given
+	reporter := &fakeAuditReporter{}
+	executor := NewApprovalExecutor(loggerx.NewNoop(), nil, reporter)
+	policy := config.ApprovalPolicy{
+		Commands:          []string{"kubectl delete"},
+		Approvers:         []string{"id-alice", "id-bob", "id-carol"},
+		RequiredApprovals: 2,
+	}
+	requestCtx := fixApprovalCmdCtx("kubectl delete pod nginx", "carol")
+
+	// when
+	msg := executor.RequestApproval(context.Background(), policy, "botkube/kubectl", requestCtx)
+
+	// then
+	require.Len(t, msg.Sections, 1)
+	require.Len(t, msg.Sections[0].Buttons, 2)
+	assert.Equal(t, "kubectl delete pod nginx", msg.Sections[0].Body.CodeBlock)
+
+	approveCmd := msg.Sections[0].Buttons[0].Command
+	denyCmd := msg.Sections[0].Buttons[1].Command
+	id := approveCmd[len(api.MessageBotNamePlaceholder+" approve request "):]
+	assert.Equal(t, api.MessageBotNamePlaceholder+" deny request "+id, denyCmd)
+
+	// when
+	notAllowed, err := executor.Approve(context.Background(), fixApprovalCmdCtx("approve request "+id, "eve"))
+	require.NoError(t, err)
+	impersonatorCtx := fixApprovalCmdCtx("approve request "+id, "alice")
+	impersonatorCtx.User.ID = "id-eve"
+	impersonator, err := executor.Approve(context.Background(), impersonatorCtx)
+	require.NoError(t, err)
+	self, err := executor.Approve(context.Background(), fixApprovalCmdCtx("approve request "+id, "carol"))
+	require.NoError(t, err)
+	approved, err := executor.Approve(context.Background(), fixApprovalCmdCtx("approve request "+id, "alice"))
+	require.NoError(t, err)
+	again, err := executor.Approve(context.Background(), fixApprovalCmdCtx("approve request "+id, "alice"))
+	require.NoError(t, err)
+	denied, err := executor.Deny(context.Background(), fixApprovalCmdCtx("deny request "+id, "bob"))
+	require.NoError(t, err)
+	afterDeny, err := executor.Approve(context.Background(), fixApprovalCmdCtx("approve request "+id, "alice"))
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, `You are not allowed to process the "`+id+`" request.`, notAllowed.BaseBody.CodeBlock)
+	assert.Equal(t, `You are not allowed to process the "`+id+`" request.`, impersonator.BaseBody.CodeBlock)
+	assert.Equal(t, `You cannot process your own "`+id+`" request.`, self.BaseBody.CodeBlock)
+	assert.Contains(t, approved.Sections[0].Description, "Already approved by: alice.")
+	assert.Equal(t, `You have already approved the "`+id+`" request.`, again.BaseBody.CodeBlock)
+	assert.Equal(t, "Command `kubectl delete pod nginx` requested by carol was denied by bob.", denied.BaseBody.CodeBlock)
+	assert.Equal(t, `Approval request "`+id+`" not found. It was already processed or it has expired.`, afterDeny.BaseBody.CodeBlock)
+
+	assert.Equal(t, []audit.ExecutorAuditEvent{
+		fixApprovalAuditEvent("botkube/kubectl", "carol", "kubectl delete pod nginx [approval "+id+" requested]"),
+		fixApprovalAuditEvent("botkube/kubectl", "alice", "kubectl delete pod nginx [approval "+id+" approved 1/2]"),
+		fixApprovalAuditEvent("botkube/kubectl", "bob", "kubectl delete pod nginx [approval "+id+" denied]"),
+	}, reporter.executorEvents)
+}
+
+func TestApprovalExecutorExpiredRequest(t *testing.T) {
+	// given
+	reporter := &fakeAuditReporter{}
+	now := time.Now()
+	executor := NewApprovalExecutor(loggerx.NewNoop(), nil, reporter)
+	executor.now = func() time.Time { return now }
+	policy := config.ApprovalPolicy{
+		Commands:  []string{"helm rollback"},
+		Approvers: []string{"id-alice"},
+		Timeout:   time.Minute,
+	}
+
+	msg := executor.RequestApproval(context.Background(), policy, "botkube/helm", fixApprovalCmdCtx("helm rollback nginx 1", "dave"))
+	id := msg.Sections[0].Buttons[0].Command[len(api.MessageBotNamePlaceholder+" approve request "):]
+
+	list, err := executor.List(context.Background(), fixApprovalCmdCtx("list requests", "alice"))
+	require.NoError(t, err)
+	assert.Contains(t, list.BaseBody.CodeBlock, id)
+	assert.Contains(t, list.BaseBody.CodeBlock, "helm rollback nginx 1")
+	assert.Contains(t, list.BaseBody.CodeBlock, "0/1")
+
+	// when
+	now = now.Add(2 * time.Minute)
+	out, err := executor.Approve(context.Background(), fixApprovalCmdCtx("approve request "+id, "alice"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `Approval request "`+id+`" has expired.`, out.BaseBody.CodeBlock)
+	assert.Equal(t, []audit.ExecutorAuditEvent{
+		fixApprovalAuditEvent("botkube/helm", "dave", "helm rollback nginx 1 [approval "+id+" requested]"),
+		fixApprovalAuditEvent("botkube/helm", "alice", "helm rollback nginx 1 [approval "+id+" expired]"),
+	}, reporter.executorEvents)
+}
+
+func fixApprovalCmdCtx(cmd, user string) CommandContext {
+	flags, _ := ParseFlags(cmd)
+	return CommandContext{
+		ExpandedRawCmd: cmd,
+		CleanCmd:       flags.CleanCmd,
+		Args:           flags.TokenizedCmd,
+		ClusterName:    "dev",
+		User:           UserInput{DisplayName: user, ID: "id-" + user},
+		Conversation:   Conversation{DisplayName: "ops"},
+		Platform:       config.SocketSlackCommPlatformIntegration,
+		ExecutorFilter: newExecutorTextFilter(""),
+	}
+}
+
+func fixApprovalAuditEvent(pluginName, user, cmd string) audit.ExecutorAuditEvent {
+	return audit.ExecutorAuditEvent{
+		PluginName:   pluginName,
+		PlatformUser: user,
+		Channel:      "ops",
+		Command:      cmd,
+	}
+}
+
+type fakeAuditReporter struct {
+	executorEvents []audit.ExecutorAuditEvent
+}
+
+func (f *fakeAuditReporter) ReportExecutorAuditEvent(_ context.Context, e audit.ExecutorAuditEvent) error {
+	// ignore fields that are not deterministic or not relevant for tests
+	e.CreatedAt = ""
+	e.BotPlatform = nil
+	f.executorEvents = append(f.executorEvents, e)
+	return nil
+}
+
+func (f *fakeAuditReporter) ReportSourceAuditEvent(context.Context, audit.SourceAuditEvent) error {
+	return nil
+}
@@ -4,16 +4,23 @@ package command
 type Verb string
 
 const (
-	PingVerb     Verb = "ping"
-	HelpVerb     Verb = "help"
-	VersionVerb  Verb = "version"
-	FeedbackVerb Verb = "feedback"
-	ListVerb     Verb = "list"
-	EnableVerb   Verb = "enable"
-	DisableVerb  Verb = "disable"
-	EditVerb     Verb = "edit"
-	StatusVerb   Verb = "status"
-	ShowVerb     Verb = "show"
+	PingVerb      Verb = "ping"
+	HelpVerb      Verb = "help"
+	VersionVerb   Verb = "version"
+	FeedbackVerb  Verb = "feedback"
+	ListVerb      Verb = "list"
+	EnableVerb    Verb = "enable"
+	DisableVerb   Verb = "disable"
+	EditVerb      Verb = "edit"
+	StatusVerb    Verb = "status"
+	ShowVerb      Verb = "show"
+	ReplayVerb    Verb = "replay"
+	ApproveVerb   Verb = "approve"
+	DenyVerb      Verb = "deny"
+	AckVerb       Verb = "ack"
+	SnoozeVerb    Verb = "snooze"
+	SilenceVerb   Verb = "silence"
+	UnsilenceVerb Verb = "unsilence"
 )
 
 func AllVerbs() []Verb {
@@ -28,5 +35,12 @@ func AllVerbs() []Verb {
 		EditVerb,
 		StatusVerb,
 		ShowVerb,
+		ReplayVerb,
+		ApproveVerb,
+		DenyVerb,
+		AckVerb,
+		SnoozeVerb,
+		SilenceVerb,
+		UnsilenceVerb,
 	}
 }
@@ -68,6 +68,41 @@ func TestConfigExecutorShowConfig(t *testing.T) {
 						    informersResyncPeriod: 0s
 						    kubeconfig: ""
 						    saCredentialsPathPrefix: ""
+						    deliveryRetry:
+						        enabled: false
+						        queueSize: 0
+						        maxAttempts: 0
+						        initialBackoff: 0s
+						        maxBackoff: 0s
+						        deadLetter:
+						            fileName: ""
+						            configMap: {}
+						            maxItems: 0
+						    audit:
+						        enabled: false
+						        type: ""
+						        file:
+						            path: ""
+						            maxSizeMB: 0
+						            maxBackups: 0
+						            maxAgeDays: 0
+						            compress: false
+						        webhook:
+						            url: ""
+						    leaderElection:
+						        enabled: false
+						        lease: {}
+						        identity: ""
+						        leaseDuration: 0s
+						        renewDeadline: 0s
+						        retryPeriod: 0s
+						    outputPagination:
+						        enabled: false
+						        pageSize: 0
+						        maxPages: 0
+						        cacheTTL: 0s
+						    notificationActions:
+						        silencers: []
 						configWatcher:
 						    enabled: false
 						    remote:
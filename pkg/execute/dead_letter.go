@@ -0,0 +1,64 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+var (
+	deadLetterFeatureName = FeatureName{Name: "deadletter", Aliases: []string{"deadletters"}}
+)
+
+// DeadLetterReplayer replays notifications that couldn't be delivered.
+type DeadLetterReplayer interface {
+	ReplayDeadLetters(ctx context.Context) (int, error)
+}
+
+// DeadLetterExecutor executes all commands that are related to undelivered notifications.
+type DeadLetterExecutor struct {
+	log      logrus.FieldLogger
+	replayer DeadLetterReplayer
+}
+
+// NewDeadLetterExecutor returns a new DeadLetterExecutor instance.
+func NewDeadLetterExecutor(log logrus.FieldLogger, replayer DeadLetterReplayer) *DeadLetterExecutor {
+	return &DeadLetterExecutor{
+		log:      log,
+		replayer: replayer,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor
+func (e *DeadLetterExecutor) FeatureName() FeatureName {
+	return deadLetterFeatureName
+}
+
+// Commands returns slice of commands the executor supports
+func (e *DeadLetterExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ReplayVerb: e.Replay,
+	}
+}
+
+// Replay enqueues all undelivered notifications again.
+func (e *DeadLetterExecutor) Replay(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if e.replayer == nil {
+		return respond("Replaying undelivered notifications is not supported.", cmdCtx), nil
+	}
+
+	e.log.Debug("Replaying dead letter notifications...")
+	count, err := e.replayer.ReplayDeadLetters(ctx)
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while replaying dead letter notifications: %w", err)
+	}
+
+	if count == 0 {
+		return respond("There are no undelivered notifications to replay.", cmdCtx), nil
+	}
+	return respond(fmt.Sprintf("Scheduled %d undelivered notification(s) for redelivery.", count), cmdCtx), nil
+}
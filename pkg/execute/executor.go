@@ -11,6 +11,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/metrics"
 	remoteapi "github.com/kubeshop/botkube/internal/remote"
 	"github.com/kubeshop/botkube/pkg/api"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
@@ -50,6 +51,7 @@ type DefaultExecutor struct {
 	configExecutor        *ConfigExecutor
 	execExecutor          *ExecExecutor
 	sourceExecutor        *SourceExecutor
+	approvalExecutor      *ApprovalExecutor
 	notifierHandler       NotifierHandler
 	message               string
 	platform              config.CommPlatformIntegration
@@ -97,7 +99,7 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 	cmdCtx.ProvidedClusterName = flags.ClusterName
 	cmdCtx.CmdHeader = flags.CmdHeader
 	cmdCtx.Args = flags.TokenizedCmd
-	cmdCtx.ExecutorFilter = newExecutorTextFilter(flags.Filter)
+	cmdCtx.ExecutorFilter = newExecutorFilter(flags)
 
 	if len(cmdCtx.Args) == 0 {
 		if e.conversation.IsKnown {
@@ -128,13 +130,26 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 	isPluginCmd := e.pluginExecutor.CanHandle(e.conversation.ExecutorBindings, cmdCtx.Args)
 	if isPluginCmd {
 		_, fullPluginName := e.pluginExecutor.getEnabledPlugins(e.conversation.ExecutorBindings, cmdCtx.Args[0])
+
+		policy := e.pluginExecutor.GetApprovalPolicy(e.conversation.ExecutorBindings, cmdCtx.Args)
+		requiresApproval, err := policy.RequiresApproval(cmdCtx.CleanCmd)
+		if err != nil {
+			e.log.Errorf("while checking approval policy for command %q: %s", cmdCtx.CleanCmd, err.Error())
+			return respond(fmt.Sprintf(internalErrorMsgFmt, cmdCtx.ClusterName), cmdCtx)
+		}
+		if requiresApproval && !isHelpCmd(cmdCtx.Args) {
+			return e.approvalExecutor.RequestApproval(ctx, *policy, fullPluginName, cmdCtx)
+		}
+
 		e.reportCommand(ctx, fullPluginName, e.pluginExecutor.GetCommandPrefix(cmdCtx.Args), cmdCtx.ExecutorFilter.IsActive(), cmdCtx)
 
 		if isHelpCmd(cmdCtx.Args) {
 			return e.ExecuteHelp(ctx, cmdCtx)
 		}
 
+		start := time.Now()
 		out, err := e.pluginExecutor.Execute(ctx, e.conversation.ExecutorBindings, e.conversation.SlackState, cmdCtx)
+		metrics.ObserveExecutorCommand(fullPluginName, time.Since(start), err)
 		switch {
 		case err == nil:
 		case IsExecutionCommandError(err):
@@ -183,7 +198,9 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 		e.reportCommand(ctx, "", cmdToReport, false, cmdCtx)
 	}
 
+	start := time.Now()
 	msg, err := fn(ctx, cmdCtx)
+	metrics.ObserveExecutorCommand(metrics.BuiltinExecutorLabel, time.Since(start), err)
 	switch {
 	case err == nil:
 	case errors.Is(err, errInvalidCommand):
@@ -265,6 +282,11 @@ func (e *DefaultExecutor) reportCommand(ctx context.Context, pluginName, verb st
 }
 
 func (e *DefaultExecutor) reportAuditEvent(ctx context.Context, pluginName string, cmdCtx CommandContext) error {
+	event := newExecutorAuditEvent(pluginName, cmdCtx.ExpandedRawCmd, cmdCtx)
+	return e.auditReporter.ReportExecutorAuditEvent(ctx, event)
+}
+
+func newExecutorAuditEvent(pluginName, cmd string, cmdCtx CommandContext) audit.ExecutorAuditEvent {
 	platform := remoteapi.NewBotPlatform(cmdCtx.Platform.String())
 
 	channelName := cmdCtx.Conversation.ID
@@ -272,15 +294,14 @@ func (e *DefaultExecutor) reportAuditEvent(ctx context.Context, pluginName strin
 		channelName = cmdCtx.Conversation.DisplayName
 	}
 
-	event := audit.ExecutorAuditEvent{
+	return audit.ExecutorAuditEvent{
 		PlatformUser: cmdCtx.User.DisplayName,
 		CreatedAt:    time.Now().Format(time.RFC3339),
 		PluginName:   pluginName,
 		Channel:      channelName,
-		Command:      cmdCtx.ExpandedRawCmd,
+		Command:      cmd,
 		BotPlatform:  platform,
 	}
-	return e.auditReporter.ReportExecutorAuditEvent(ctx, event)
 }
 
 // appendByUserOnlyIfNeeded returns the "by Foo" only if the command was executed via button.
@@ -3,8 +3,14 @@ package execute
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"strings"
+	"text/tabwriter"
 
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/kubeshop/botkube/internal/executor/x/parser"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 )
 
@@ -47,6 +53,228 @@ func (f *executorTextFilter) Apply(text string) string {
 	return strings.TrimSuffix(out.String(), "\n")
 }
 
+var _ executorFilter = &executorInvertTextFilter{}
+
+// executorInvertTextFilter removes lines containing a given text value from executor text results.
+type executorInvertTextFilter struct {
+	value []byte
+}
+
+// newExecutorInvertTextFilter creates a new executorInvertTextFilter.
+func newExecutorInvertTextFilter(val string) *executorInvertTextFilter {
+	return &executorInvertTextFilter{
+		value: []byte(val),
+	}
+}
+
+// IsActive whether this filter will actually mutate the output or not.
+func (f *executorInvertTextFilter) IsActive() bool {
+	return len(f.value) > 0
+}
+
+// Apply implements executorFilter to apply filtering.
+func (f *executorInvertTextFilter) Apply(text string) string {
+	if !f.IsActive() {
+		return text
+	}
+
+	var out strings.Builder
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		scanned := scanner.Bytes()
+		if !bytes.Contains(scanned, f.value) {
+			out.Write(scanned)
+			out.WriteString("\n")
+		}
+	}
+
+	return strings.TrimSuffix(out.String(), "\n")
+}
+
+var _ executorFilter = &executorLinesFilter{}
+
+// executorLinesFilter limits executor text results to the first and/or last N lines.
+type executorLinesFilter struct {
+	head int
+	tail int
+}
+
+// IsActive whether this filter will actually mutate the output or not.
+func (f *executorLinesFilter) IsActive() bool {
+	return f.head > 0 || f.tail > 0
+}
+
+// Apply implements executorFilter to apply filtering. When both limits are set, the head is applied first.
+func (f *executorLinesFilter) Apply(text string) string {
+	if !f.IsActive() || text == "" {
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	if f.head > 0 && len(lines) > f.head {
+		lines = lines[:f.head]
+	}
+	if f.tail > 0 && len(lines) > f.tail {
+		lines = lines[len(lines)-f.tail:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+var _ executorFilter = &executorColumnsFilter{}
+
+// executorColumnsFilter displays only the given columns of table outputs, such as 'kubectl get' or 'helm list'.
+// Column boundaries are derived from the header offsets, so cells may contain single spaces.
+type executorColumnsFilter struct {
+	columns []string
+}
+
+// IsActive whether this filter will actually mutate the output or not.
+func (f *executorColumnsFilter) IsActive() bool {
+	return len(f.columns) > 0
+}
+
+// Apply implements executorFilter to apply filtering. Each table separated by an empty line is processed separately.
+// Tables without any of the requested columns are returned as they are.
+func (f *executorColumnsFilter) Apply(text string) string {
+	if !f.IsActive() || text == "" {
+		return text
+	}
+
+	tables := strings.Split(text, "\n\n")
+	for idx, table := range tables {
+		tables[idx] = f.selectColumns(table)
+	}
+	return strings.Join(tables, "\n\n")
+}
+
+func (f *executorColumnsFilter) selectColumns(in string) string {
+	var headerAligned parser.TableHeaderAligned
+	table := headerAligned.TableSeparated(in).Table
+
+	var indexes []int
+	for _, name := range f.columns {
+		for idx, header := range table.Headers {
+			if strings.EqualFold(header, name) {
+				indexes = append(indexes, idx)
+				break
+			}
+		}
+	}
+	if len(indexes) == 0 {
+		return in
+	}
+
+	var out strings.Builder
+	w := tabwriter.NewWriter(&out, 0, 0, 3, ' ', 0)
+	for _, row := range append([][]string{table.Headers}, table.Rows...) {
+		cells := make([]string, 0, len(indexes))
+		for _, idx := range indexes {
+			if idx < len(row) {
+				cells = append(cells, row[idx])
+				continue
+			}
+			cells = append(cells, "")
+		}
+		_, _ = fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+	_ = w.Flush()
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	for idx, line := range lines {
+		lines[idx] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+var _ executorFilter = &executorJSONPathFilter{}
+
+// executorJSONPathFilter applies a JSONPath template on executor JSON results, similar to 'kubectl -o jsonpath'.
+type executorJSONPathFilter struct {
+	template string
+}
+
+// IsActive whether this filter will actually mutate the output or not.
+func (f *executorJSONPathFilter) IsActive() bool {
+	return f.template != ""
+}
+
+// Apply implements executorFilter to apply filtering. As the output cannot be returned as it is, all errors
+// are returned as the filtered output.
+func (f *executorJSONPathFilter) Apply(text string) string {
+	if !f.IsActive() || strings.TrimSpace(text) == "" {
+		return text
+	}
+
+	jp, err := parseJSONPath(f.template)
+	if err != nil {
+		return fmt.Sprintf("Cannot parse the --jsonpath template: %s", err)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(text), &data); err != nil {
+		return fmt.Sprintf("Cannot apply the --jsonpath template, as the output is not a valid JSON: %s", err)
+	}
+
+	var out bytes.Buffer
+	if err := jp.Execute(&out, data); err != nil {
+		return fmt.Sprintf("Cannot apply the --jsonpath template: %s", err)
+	}
+	return out.String()
+}
+
+// parseJSONPath parses a given JSONPath template. Similar to kubectl, the curly braces are optional.
+func parseJSONPath(in string) (*jsonpath.JSONPath, error) {
+	in = strings.TrimSpace(in)
+	if !strings.HasPrefix(in, "{") {
+		in = fmt.Sprintf("{%s}", in)
+	}
+
+	jp := jsonpath.New("output").AllowMissingKeys(true)
+	if err := jp.Parse(in); err != nil {
+		return nil, err
+	}
+	return jp, nil
+}
+
+var _ executorFilter = executorFilters{}
+
+// executorFilters applies all given filters one by one.
+type executorFilters []executorFilter
+
+// newExecutorFilter creates a filter for all output flags. Filters are applied in the following order:
+// --jsonpath, --columns, --filter, --grep-v, --bk-head and --bk-tail.
+func newExecutorFilter(flags Flags) executorFilters {
+	return executorFilters{
+		&executorJSONPathFilter{template: flags.JSONPath},
+		&executorColumnsFilter{columns: flags.Columns},
+		newExecutorTextFilter(flags.Filter),
+		newExecutorInvertTextFilter(flags.InvertFilter),
+		&executorLinesFilter{head: flags.Head, tail: flags.Tail},
+	}
+}
+
+// IsActive whether at least one filter will actually mutate the output.
+func (f executorFilters) IsActive() bool {
+	for _, filter := range f {
+		if filter.IsActive() {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply implements executorFilter to apply all active filters.
+func (f executorFilters) Apply(text string) string {
+	for _, filter := range f {
+		if !filter.IsActive() {
+			continue
+		}
+		text = filter.Apply(text)
+	}
+	return text
+}
+
 func appendInteractiveFilterIfNeeded(body string, msg interactive.CoreMessage, cmdCtx CommandContext) interactive.CoreMessage {
 	if !cmdCtx.Platform.IsInteractive() {
 		return msg
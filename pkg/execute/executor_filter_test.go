@@ -129,3 +129,86 @@ func TestExtractExecutorFilter_NoErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutorFilters_Apply(t *testing.T) {
+	pods := heredoc.Doc(`
+		NAMESPACE     NAME                       READY   STATUS             RESTARTS      AGE
+		default       nginx-7c5ddbdf54-2x4jm     1/1     Running            0             30m
+		default       worker-6d4cf56db6-8kxzq    0/1     CrashLoopBackOff   5 (2m ago)    30m
+		kube-system   coredns-558bd4d5db-c5gwx   1/1     Running            0             30m`)
+
+	testCases := []struct {
+		name     string
+		flags    Flags
+		text     string
+		expected string
+	}{
+		{
+			name:  "select columns",
+			flags: Flags{Columns: []string{"name", "RESTARTS"}},
+			text:  pods,
+			expected: heredoc.Doc(`
+				NAME                       RESTARTS
+				nginx-7c5ddbdf54-2x4jm     0
+				worker-6d4cf56db6-8kxzq    5 (2m ago)
+				coredns-558bd4d5db-c5gwx   0`),
+		},
+		{
+			name:     "ignore unknown columns",
+			flags:    Flags{Columns: []string{"IP"}},
+			text:     pods,
+			expected: pods,
+		},
+		{
+			name:  "invert filter",
+			flags: Flags{InvertFilter: "kube-system"},
+			text:  pods,
+			expected: heredoc.Doc(`
+				NAMESPACE     NAME                       READY   STATUS             RESTARTS      AGE
+				default       nginx-7c5ddbdf54-2x4jm     1/1     Running            0             30m
+				default       worker-6d4cf56db6-8kxzq    0/1     CrashLoopBackOff   5 (2m ago)    30m`),
+		},
+		{
+			name:  "head and tail",
+			flags: Flags{Head: 3, Tail: 1},
+			text:  pods,
+			expected: heredoc.Doc(`
+				default       worker-6d4cf56db6-8kxzq    0/1     CrashLoopBackOff   5 (2m ago)    30m`),
+		},
+		{
+			name:  "columns are selected before filtering",
+			flags: Flags{Columns: []string{"NAME", "STATUS"}, Filter: "Running", InvertFilter: "coredns"},
+			text:  pods,
+			expected: heredoc.Doc(`
+				nginx-7c5ddbdf54-2x4jm     Running`),
+		},
+		{
+			name:     "JSONPath",
+			flags:    Flags{JSONPath: ".items[*].metadata.name"},
+			text:     `{"items": [{"metadata": {"name": "nginx"}}, {"metadata": {"name": "worker"}}]}`,
+			expected: "nginx worker",
+		},
+		{
+			name:     "JSONPath on invalid JSON",
+			flags:    Flags{JSONPath: "{.items}"},
+			text:     "NAME   READY",
+			expected: "Cannot apply the --jsonpath template, as the output is not a valid JSON: invalid character 'N' looking for beginning of value",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filter := newExecutorFilter(tc.flags)
+			assert.True(t, filter.IsActive())
+			assert.Equal(t, tc.expected, filter.Apply(tc.text))
+		})
+	}
+}
+
+func TestExecutorFilters_Inactive(t *testing.T) {
+	var filter executorFilter = newExecutorFilter(Flags{})
+
+	text := "Please return this same text."
+	assert.Equal(t, text, filter.Apply(text))
+	assert.False(t, filter.IsActive())
+}
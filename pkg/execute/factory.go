@@ -31,21 +31,24 @@ type DefaultExecutorFactory struct {
 	configExecutor        *ConfigExecutor
 	execExecutor          *ExecExecutor
 	sourceExecutor        *SourceExecutor
+	approvalExecutor      *ApprovalExecutor
 	cmdsMapping           *CommandMapping
 	auditReporter         audit.AuditReporter
 }
 
 // DefaultExecutorFactoryParams contains input parameters for DefaultExecutorFactory.
 type DefaultExecutorFactoryParams struct {
-	Log               logrus.FieldLogger
-	Cfg               config.Config
-	CfgManager        config.PersistenceManager
-	AnalyticsReporter AnalyticsReporter
-	CommandGuard      CommandGuard
-	PluginManager     *plugin.Manager
-	RestCfg           *rest.Config
-	BotKubeVersion    string
-	AuditReporter     audit.AuditReporter
+	Log                logrus.FieldLogger
+	Cfg                config.Config
+	CfgManager         config.PersistenceManager
+	AnalyticsReporter  AnalyticsReporter
+	CommandGuard       CommandGuard
+	PluginManager      *plugin.Manager
+	RestCfg            *rest.Config
+	BotKubeVersion     string
+	AuditReporter      audit.AuditReporter
+	DeadLetterReplayer DeadLetterReplayer
+	NotificationStore  NotificationStore
 }
 
 // Executor is an interface for processes to execute commands
@@ -113,6 +116,32 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		params.Log.WithField("component", "Alias Executor"),
 		params.Cfg,
 	)
+	deadLetterExecutor := NewDeadLetterExecutor(
+		params.Log.WithField("component", "Dead Letter Executor"),
+		params.DeadLetterReplayer,
+	)
+	outputExecutor := NewOutputExecutor(
+		params.Log.WithField("component", "Output Executor"),
+		params.Cfg.Settings.OutputPagination,
+	)
+	pluginExecutor := NewPluginExecutor(
+		params.Log.WithField("component", "Botkube Plugin Executor"),
+		params.Cfg,
+		params.PluginManager,
+		params.RestCfg,
+		outputExecutor,
+	)
+	notificationExecutor := NewNotificationExecutor(
+		params.Log.WithField("component", "Notification Executor"),
+		params.Cfg.Settings.NotificationActions,
+		params.NotificationStore,
+		params.AuditReporter,
+	)
+	approvalExecutor := NewApprovalExecutor(
+		params.Log.WithField("component", "Approval Executor"),
+		pluginExecutor,
+		params.AuditReporter,
+	)
 
 	executors := []CommandExecutor{
 		actionExecutor,
@@ -126,22 +155,21 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		execExecutor,
 		sourceExecutor,
 		aliasExecutor,
+		deadLetterExecutor,
+		approvalExecutor,
+		notificationExecutor,
+		outputExecutor,
 	}
 	mappings, err := NewCmdsMapping(executors)
 	if err != nil {
 		return nil, err
 	}
 	return &DefaultExecutorFactory{
-		log:               params.Log,
-		cfg:               params.Cfg,
-		analyticsReporter: params.AnalyticsReporter,
-		notifierExecutor:  notifierExecutor,
-		pluginExecutor: NewPluginExecutor(
-			params.Log.WithField("component", "Botkube Plugin Executor"),
-			params.Cfg,
-			params.PluginManager,
-			params.RestCfg,
-		),
+		log:                   params.Log,
+		cfg:                   params.Cfg,
+		analyticsReporter:     params.AnalyticsReporter,
+		notifierExecutor:      notifierExecutor,
+		pluginExecutor:        pluginExecutor,
 		sourceBindingExecutor: sourceBindingExecutor,
 		actionExecutor:        actionExecutor,
 		pingExecutor:          pingExecutor,
@@ -151,6 +179,7 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		configExecutor:        configExecutor,
 		execExecutor:          execExecutor,
 		sourceExecutor:        sourceExecutor,
+		approvalExecutor:      approvalExecutor,
 		cmdsMapping:           mappings,
 		auditReporter:         params.AuditReporter,
 	}, nil
@@ -182,6 +211,12 @@ type NewDefaultInput struct {
 type UserInput struct {
 	Mention     string
 	DisplayName string
+	// ID is the chat platform user identifier.
+	ID string
+	// Email is available only on platforms that expose it.
+	Email string
+	// Groups holds the chat platform groups the user belongs to, such as Discord or Mattermost roles.
+	Groups []string
 }
 
 // NewDefault creates new Default Executor.
@@ -201,6 +236,7 @@ func (f *DefaultExecutorFactory) NewDefault(cfg NewDefaultInput) Executor {
 		configExecutor:        f.configExecutor,
 		execExecutor:          f.execExecutor,
 		sourceExecutor:        f.sourceExecutor,
+		approvalExecutor:      f.approvalExecutor,
 		cmdsMapping:           f.cmdsMapping,
 		auditReporter:         f.auditReporter,
 		user:                  cfg.User,
@@ -0,0 +1,248 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/storage"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+const (
+	defaultSnoozeDuration              = time.Hour
+	notificationActionsNotSupportedMsg = "Notification actions are not supported."
+)
+
+var (
+	notificationFeatureName = FeatureName{Name: api.NotificationFeatureName, Aliases: []string{"notifications", "notif"}}
+)
+
+// NotificationStore persists acknowledged, snoozed and silenced notifications.
+type NotificationStore interface {
+	Acknowledge(ctx context.Context, key, by string) (storage.Acknowledgement, error)
+	Snooze(ctx context.Context, key, by string, duration time.Duration) (storage.Silence, error)
+	Silence(ctx context.Context, key, by string) (storage.Silence, error)
+	Unsilence(ctx context.Context, key string) (bool, error)
+	Silences() []storage.Silence
+	Message(id string) (api.Message, bool)
+}
+
+// NotificationExecutor executes acknowledge, snooze and silence actions for notifications sent by sources.
+// Snooze, silence and unsilence actions are allowed only for configured silencers and reported as audit events.
+type NotificationExecutor struct {
+	log           logrus.FieldLogger
+	cfg           config.NotificationActions
+	store         NotificationStore
+	auditReporter audit.AuditReporter
+}
+
+// NewNotificationExecutor returns a new NotificationExecutor instance.
+func NewNotificationExecutor(log logrus.FieldLogger, cfg config.NotificationActions, store NotificationStore, auditReporter audit.AuditReporter) *NotificationExecutor {
+	return &NotificationExecutor{
+		log:           log,
+		cfg:           cfg,
+		store:         store,
+		auditReporter: auditReporter,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor
+func (e *NotificationExecutor) FeatureName() FeatureName {
+	return notificationFeatureName
+}
+
+// Commands returns slice of commands the executor supports
+func (e *NotificationExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.AckVerb:       e.Ack,
+		command.SnoozeVerb:    e.Snooze,
+		command.SilenceVerb:   e.Silence,
+		command.UnsilenceVerb: e.Unsilence,
+		command.ListVerb:      e.List,
+	}
+}
+
+// Ack records who acknowledged a given notification. If the command contains the ID of a remembered message,
+// the message is edited to show the acknowledgement.
+func (e *NotificationExecutor) Ack(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	key, msg, ok := e.keyFromArgs(cmdCtx)
+	if !ok {
+		return msg, nil
+	}
+
+	by := userName(cmdCtx.User)
+	ack, err := e.store.Acknowledge(ctx, key, by)
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while acknowledging notification %q: %w", key, err)
+	}
+	e.log.WithFields(logrus.Fields{"key": key, "user": by}).Info("Notification acknowledged")
+
+	// the message ID is missing if the command was typed by a user
+	var msgID string
+	if len(cmdCtx.Args) > 3 {
+		msgID = cmdCtx.Args[3]
+	}
+	original, found := e.store.Message(msgID)
+	if !found {
+		return respond(fmt.Sprintf("Notification %q acknowledged by %s.", key, by), cmdCtx), nil
+	}
+
+	return interactive.CoreMessage{
+		Message: acknowledgedMessage(original, api.NotificationActionCommand(api.NotificationAckVerb, key, msgID), ack),
+	}, nil
+}
+
+// Snooze suppresses notifications for a given key for a given duration, 1h by default.
+func (e *NotificationExecutor) Snooze(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	key, msg, ok := e.silencerKeyFromArgs(cmdCtx)
+	if !ok {
+		return msg, nil
+	}
+
+	duration := defaultSnoozeDuration
+	if len(cmdCtx.Args) > 3 {
+		parsed, err := time.ParseDuration(cmdCtx.Args[3])
+		if err != nil || parsed <= 0 {
+			return respond(fmt.Sprintf("Invalid snooze duration %q. Use e.g. 1h or 30m.", cmdCtx.Args[3]), cmdCtx), nil
+		}
+		duration = parsed
+	}
+
+	by := userName(cmdCtx.User)
+	if _, err := e.store.Snooze(ctx, key, by, duration); err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while snoozing notification %q: %w", key, err)
+	}
+	e.log.WithFields(logrus.Fields{"key": key, "user": by, "duration": duration}).Info("Notification snoozed")
+	e.reportAudit(ctx, cmdCtx)
+
+	return respond(fmt.Sprintf("Notifications for %q snoozed for %s by %s.", key, api.FormatSnoozeDuration(duration), by), cmdCtx), nil
+}
+
+// Silence suppresses notifications for a given key until the silence is removed.
+func (e *NotificationExecutor) Silence(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	key, msg, ok := e.silencerKeyFromArgs(cmdCtx)
+	if !ok {
+		return msg, nil
+	}
+
+	by := userName(cmdCtx.User)
+	if _, err := e.store.Silence(ctx, key, by); err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while silencing notification %q: %w", key, err)
+	}
+	e.log.WithFields(logrus.Fields{"key": key, "user": by}).Info("Notification silenced")
+	e.reportAudit(ctx, cmdCtx)
+
+	unsilenceCmd := api.NotificationActionCommand(api.NotificationUnsilenceVerb, key)
+	return respond(fmt.Sprintf("Notifications for %q silenced by %s. To remove the silence rule, run: %s %s", key, by, api.MessageBotNamePlaceholder, unsilenceCmd), cmdCtx), nil
+}
+
+// Unsilence removes a snooze or silence rule for a given key.
+func (e *NotificationExecutor) Unsilence(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	key, msg, ok := e.silencerKeyFromArgs(cmdCtx)
+	if !ok {
+		return msg, nil
+	}
+
+	found, err := e.store.Unsilence(ctx, key)
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while removing silence for notification %q: %w", key, err)
+	}
+	if !found {
+		return respond(fmt.Sprintf("Notifications for %q are not snoozed or silenced.", key), cmdCtx), nil
+	}
+	e.log.WithFields(logrus.Fields{"key": key, "user": userName(cmdCtx.User)}).Info("Notification unsilenced")
+	e.reportAudit(ctx, cmdCtx)
+
+	return respond(fmt.Sprintf("Notifications for %q are no longer suppressed.", key), cmdCtx), nil
+}
+
+// List returns a tabular representation of snoozed and silenced notifications.
+func (e *NotificationExecutor) List(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if e.store == nil {
+		return respond(notificationActionsNotSupportedMsg, cmdCtx), nil
+	}
+
+	silences := e.store.Silences()
+	if len(silences) == 0 {
+		return respond("There are no snoozed or silenced notifications.", cmdCtx), nil
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "KEY\tCREATED BY\tEXPIRES")
+	for _, silence := range silences {
+		expires := "never"
+		if silence.ExpiresAt != nil {
+			expires = silence.ExpiresAt.UTC().Format(time.RFC1123)
+		}
+		fmt.Fprintf(w, "\n%s\t%s\t%s", silence.Key, silence.CreatedBy, expires)
+	}
+	w.Flush()
+
+	return respond(buf.String(), cmdCtx), nil
+}
+
+func (e *NotificationExecutor) keyFromArgs(cmdCtx CommandContext) (string, interactive.CoreMessage, bool) {
+	if e.store == nil {
+		return "", respond(notificationActionsNotSupportedMsg, cmdCtx), false
+	}
+	if len(cmdCtx.Args) < 3 {
+		return "", respond(incompleteCmdMsg, cmdCtx), false
+	}
+	return cmdCtx.Args[2], interactive.CoreMessage{}, true
+}
+
+// silencerKeyFromArgs returns the notification key only if the user is allowed to snooze and silence notifications.
+func (e *NotificationExecutor) silencerKeyFromArgs(cmdCtx CommandContext) (string, interactive.CoreMessage, bool) {
+	key, msg, ok := e.keyFromArgs(cmdCtx)
+	if !ok {
+		return "", msg, false
+	}
+	if !e.cfg.IsSilencer(cmdCtx.User.ID, cmdCtx.User.Mention) {
+		return "", respond("You are not allowed to snooze or silence notifications.", cmdCtx), false
+	}
+	return key, interactive.CoreMessage{}, true
+}
+
+func (e *NotificationExecutor) reportAudit(ctx context.Context, cmdCtx CommandContext) {
+	if err := e.auditReporter.ReportExecutorAuditEvent(ctx, newExecutorAuditEvent(api.NotificationFeatureName, cmdCtx.ExpandedRawCmd, cmdCtx)); err != nil {
+		e.log.Errorf("while reporting notification audit event: %s", err.Error())
+	}
+}
+
+// acknowledgedMessage returns a copy of a given message with the acknowledgement details and without the acknowledge button.
+func acknowledgedMessage(original api.Message, ackCmd string, ack storage.Acknowledgement) api.Message {
+	ackCmd = fmt.Sprintf("%s %s", api.MessageBotNamePlaceholder, ackCmd)
+
+	out := original
+	out.ReplaceOriginal = true
+	out.Sections = make([]api.Section, 0, len(original.Sections))
+	for idx, section := range original.Sections {
+		var btns api.Buttons
+		for _, btn := range section.Buttons {
+			if btn.Command == ackCmd {
+				continue
+			}
+			btns = append(btns, btn)
+		}
+		section.Buttons = btns
+
+		if idx == 0 {
+			section.Context = append(api.ContextItems{}, section.Context...)
+			section.Context = append(section.Context, api.ContextItem{
+				Text: fmt.Sprintf("✅ Acknowledged by %s at %s", ack.By, ack.At.UTC().Format(time.RFC1123)),
+			})
+		}
+		out.Sections = append(out.Sections, section)
+	}
+	return out
+}
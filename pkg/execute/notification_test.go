@@ -0,0 +1,149 @@
+package execute
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/storage"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const fixNotificationKey = "kubernetes/Pod/default/nginx"
+
+func TestNotificationExecutorAck(t *testing.T) {
+	// given
+	store := storage.NewForNotifications("botkube", "botkube-system", fake.NewSimpleClientset())
+	executor := NewNotificationExecutor(loggerx.NewNoop(), config.NotificationActions{}, store, &fakeAuditReporter{})
+
+	fixMessage := func(header string) api.Message {
+		return api.Message{
+			Sections: []api.Section{
+				{Base: api.Base{Header: header}},
+				api.NotificationActionsSection(fixNotificationKey),
+			},
+		}
+	}
+	older := store.RememberMessage(fixNotificationKey, fixMessage("Pod default/nginx failed"))
+	store.RememberMessage(fixNotificationKey, fixMessage("Pod default/nginx failed again"))
+
+	// when
+	ackCmd := strings.TrimPrefix(older.Sections[1].Buttons[0].Command, api.MessageBotNamePlaceholder+" ")
+	msg, err := executor.Ack(context.Background(), fixApprovalCmdCtx(ackCmd, "alice"))
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, msg.ReplaceOriginal)
+	require.Len(t, msg.Sections, 2)
+	assert.Equal(t, "Pod default/nginx failed", msg.Sections[0].Header)
+	require.Len(t, msg.Sections[0].Context, 1)
+	assert.Contains(t, msg.Sections[0].Context[0].Text, "Acknowledged by alice")
+
+	var btnNames []string
+	for _, btn := range msg.Sections[1].Buttons {
+		btnNames = append(btnNames, btn.Name)
+	}
+	assert.Equal(t, []string{"Snooze 1h", "Snooze 24h", "Silence rule"}, btnNames)
+}
+
+func TestNotificationExecutorAckUnknownMessage(t *testing.T) {
+	// given
+	store := storage.NewForNotifications("botkube", "botkube-system", fake.NewSimpleClientset())
+	executor := NewNotificationExecutor(loggerx.NewNoop(), config.NotificationActions{}, store, &fakeAuditReporter{})
+
+	// when
+	msg, err := executor.Ack(context.Background(), fixApprovalCmdCtx("ack notification "+fixNotificationKey, "alice"))
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, msg.ReplaceOriginal)
+	assert.Equal(t, `Notification "kubernetes/Pod/default/nginx" acknowledged by alice.`, msg.BaseBody.CodeBlock)
+}
+
+func TestNotificationExecutorSnoozeAndSilence(t *testing.T) {
+	// given
+	ctx := context.Background()
+	k8sCli := fake.NewSimpleClientset()
+	store := storage.NewForNotifications("botkube", "botkube-system", k8sCli)
+	reporter := &fakeAuditReporter{}
+	cfg := config.NotificationActions{Silencers: []string{"id-alice", "id-bob"}}
+	executor := NewNotificationExecutor(loggerx.NewNoop(), cfg, store, reporter)
+
+	// when
+	snoozed, err := executor.Snooze(ctx, fixApprovalCmdCtx("snooze notification "+fixNotificationKey+" 24h", "alice"))
+	require.NoError(t, err)
+	silenced, err := executor.Silence(ctx, fixApprovalCmdCtx("silence notification prometheus/KubePodCrashLooping", "bob"))
+	require.NoError(t, err)
+	invalid, err := executor.Snooze(ctx, fixApprovalCmdCtx("snooze notification "+fixNotificationKey+" forever", "alice"))
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, `Notifications for "kubernetes/Pod/default/nginx" snoozed for 24h by alice.`, snoozed.BaseBody.CodeBlock)
+	assert.Contains(t, silenced.BaseBody.CodeBlock, `Notifications for "prometheus/KubePodCrashLooping" silenced by bob.`)
+	assert.Equal(t, `Invalid snooze duration "forever". Use e.g. 1h or 30m.`, invalid.BaseBody.CodeBlock)
+
+	assert.True(t, store.IsSuppressed(fixNotificationKey))
+	assert.True(t, store.IsSuppressed("prometheus/KubePodCrashLooping"))
+	assert.False(t, store.IsSuppressed("kubernetes/Pod/default/other"))
+
+	// state is persisted in the system ConfigMap
+	restored := storage.NewForNotifications("botkube", "botkube-system", k8sCli)
+	require.NoError(t, restored.Load(ctx))
+	silences := restored.Silences()
+	require.Len(t, silences, 2)
+	assert.Equal(t, fixNotificationKey, silences[0].Key)
+	require.NotNil(t, silences[0].ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), *silences[0].ExpiresAt, time.Minute)
+	assert.Nil(t, silences[1].ExpiresAt)
+
+	// when
+	unsilenced, err := executor.Unsilence(ctx, fixApprovalCmdCtx("unsilence notification prometheus/KubePodCrashLooping", "bob"))
+	require.NoError(t, err)
+	list, err := executor.List(ctx, fixApprovalCmdCtx("list notifications", "bob"))
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, `Notifications for "prometheus/KubePodCrashLooping" are no longer suppressed.`, unsilenced.BaseBody.CodeBlock)
+	assert.False(t, store.IsSuppressed("prometheus/KubePodCrashLooping"))
+	assert.Contains(t, list.BaseBody.CodeBlock, fixNotificationKey)
+	assert.NotContains(t, list.BaseBody.CodeBlock, "prometheus/KubePodCrashLooping")
+
+	assert.Equal(t, []audit.ExecutorAuditEvent{
+		fixApprovalAuditEvent("notification", "alice", "snooze notification "+fixNotificationKey+" 24h"),
+		fixApprovalAuditEvent("notification", "bob", "silence notification prometheus/KubePodCrashLooping"),
+		fixApprovalAuditEvent("notification", "bob", "unsilence notification prometheus/KubePodCrashLooping"),
+	}, reporter.executorEvents)
+}
+
+func TestNotificationExecutorRequiresSilencer(t *testing.T) {
+	// given
+	ctx := context.Background()
+	store := storage.NewForNotifications("botkube", "botkube-system", fake.NewSimpleClientset())
+	reporter := &fakeAuditReporter{}
+	cfg := config.NotificationActions{Silencers: []string{"id-alice"}}
+	executor := NewNotificationExecutor(loggerx.NewNoop(), cfg, store, reporter)
+
+	// when
+	snoozed, err := executor.Snooze(ctx, fixApprovalCmdCtx("snooze notification "+fixNotificationKey, "mallory"))
+	require.NoError(t, err)
+	silenced, err := executor.Silence(ctx, fixApprovalCmdCtx("silence notification "+fixNotificationKey, "mallory"))
+	require.NoError(t, err)
+	unsilenced, err := executor.Unsilence(ctx, fixApprovalCmdCtx("unsilence notification "+fixNotificationKey, "mallory"))
+	require.NoError(t, err)
+
+	// then
+	for _, msg := range []interactive.CoreMessage{snoozed, silenced, unsilenced} {
+		assert.Equal(t, "You are not allowed to snooze or silence notifications.", msg.BaseBody.CodeBlock)
+	}
+	assert.False(t, store.IsSuppressed(fixNotificationKey))
+	assert.Empty(t, reporter.executorEvents)
+}
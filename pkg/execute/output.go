@@ -0,0 +1,224 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+const (
+	outputIDLength = 8
+	// maxPageLength keeps a single page below the smallest message size limit of interactive platforms,
+	// so pages are not uploaded as files.
+	maxPageLength = 2500
+	// maxCachedOutputs limits the number of outputs kept in memory. The oldest outputs are evicted first.
+	maxCachedOutputs = 200
+)
+
+var (
+	outputFeatureName = FeatureName{Name: "output", Aliases: []string{"outputs"}}
+)
+
+// OutputExecutor splits long executor outputs into pages and displays cached pages on demand.
+type OutputExecutor struct {
+	log logrus.FieldLogger
+	cfg config.OutputPagination
+
+	mu      sync.Mutex
+	outputs map[string]*pagedOutput
+	now     func() time.Time
+}
+
+type pagedOutput struct {
+	id string
+	// conversation in which the output was produced. Pages are displayed only there, so other channels
+	// cannot bypass their executor bindings.
+	conversation string
+	msg          interactive.CoreMessage
+	pages        []string
+	expiresAt    time.Time
+}
+
+// NewOutputExecutor returns a new OutputExecutor instance.
+func NewOutputExecutor(log logrus.FieldLogger, cfg config.OutputPagination) *OutputExecutor {
+	return &OutputExecutor{
+		log:     log,
+		cfg:     cfg,
+		outputs: map[string]*pagedOutput{},
+		now:     time.Now,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor
+func (e *OutputExecutor) FeatureName() FeatureName {
+	return outputFeatureName
+}
+
+// Commands returns slice of commands the executor supports
+func (e *OutputExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ShowVerb: e.Show,
+	}
+}
+
+// Paginate returns the first page of a given message if its code block doesn't fit into a single page.
+// The full output is cached, so other pages can be displayed with the 'show output' command.
+// Outputs which exceed the max number of pages are returned as they are, so bots send them as a file attachment.
+func (e *OutputExecutor) Paginate(msg interactive.CoreMessage, cmdCtx CommandContext) interactive.CoreMessage {
+	if !e.cfg.Enabled || !cmdCtx.Platform.IsInteractive() {
+		return msg
+	}
+
+	pages := splitIntoPages(msg.BaseBody.CodeBlock, e.cfg.PageSize)
+	if len(pages) < 2 {
+		return msg
+	}
+	if e.cfg.MaxPages > 0 && len(pages) > e.cfg.MaxPages {
+		e.log.Debugf("Output has %d pages, which exceeds the limit of %d. Skipping pagination...", len(pages), e.cfg.MaxPages)
+		return msg
+	}
+
+	out := &pagedOutput{
+		id:           uuid.NewString()[:outputIDLength],
+		conversation: conversationKey(cmdCtx),
+		msg:          msg,
+		pages:        pages,
+		expiresAt:    e.now().Add(e.cfg.CacheTTL),
+	}
+
+	e.mu.Lock()
+	e.removeExpired()
+	e.evictOldest(maxCachedOutputs - 1)
+	e.outputs[out.id] = out
+	e.mu.Unlock()
+
+	return out.render(0)
+}
+
+// Show displays a given page of a cached output.
+func (e *OutputExecutor) Show(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if len(cmdCtx.Args) < 4 {
+		return interactive.CoreMessage{}, errInvalidCommand
+	}
+
+	id := cmdCtx.Args[2]
+	page, err := strconv.Atoi(cmdCtx.Args[3])
+	if err != nil {
+		return respond(fmt.Sprintf("Page %q is not a valid number.", cmdCtx.Args[3]), cmdCtx), nil
+	}
+
+	e.mu.Lock()
+	e.removeExpired()
+	out, found := e.outputs[id]
+	e.mu.Unlock()
+
+	if !found || out.conversation != conversationKey(cmdCtx) {
+		return respond(fmt.Sprintf("Output %q doesn't exist or it has already expired. Run the command again.", id), cmdCtx), nil
+	}
+	if page < 1 || page > len(out.pages) {
+		return respond(fmt.Sprintf("Output %q has %d pages.", id, len(out.pages)), cmdCtx), nil
+	}
+
+	msg := out.render(page - 1)
+	msg.ReplaceOriginal = true
+	return msg, nil
+}
+
+// removeExpired must be called with the lock held.
+func (e *OutputExecutor) removeExpired() {
+	now := e.now()
+	for id, out := range e.outputs {
+		if now.After(out.expiresAt) {
+			delete(e.outputs, id)
+		}
+	}
+}
+
+// evictOldest removes the oldest outputs, so at most limit outputs are left. It must be called with the lock held.
+func (e *OutputExecutor) evictOldest(limit int) {
+	if len(e.outputs) <= limit {
+		return
+	}
+
+	outputs := make([]*pagedOutput, 0, len(e.outputs))
+	for _, out := range e.outputs {
+		outputs = append(outputs, out)
+	}
+	// all outputs have the same TTL, so the oldest ones expire first
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].expiresAt.Before(outputs[j].expiresAt)
+	})
+	for _, out := range outputs[:len(outputs)-limit] {
+		delete(e.outputs, out.id)
+	}
+}
+
+// conversationKey identifies the conversation in which a given command was executed.
+func conversationKey(cmdCtx CommandContext) string {
+	return fmt.Sprintf("%s/%s/%s", cmdCtx.Platform, cmdCtx.CommGroupName, cmdCtx.Conversation.ID)
+}
+
+// render returns the cached message with a given page as a code block and buttons to navigate between pages.
+func (o *pagedOutput) render(page int) interactive.CoreMessage {
+	msg := o.msg
+	msg.BaseBody.CodeBlock = o.pages[page]
+
+	btns := api.NewMessageButtonBuilder()
+	var buttons api.Buttons
+	if page > 0 {
+		buttons = append(buttons, btns.ForCommandWithoutDesc("Previous", o.pageCmd(page)))
+	}
+	if page < len(o.pages)-1 {
+		buttons = append(buttons, btns.ForCommandWithoutDesc("Next", o.pageCmd(page+2), api.ButtonStylePrimary))
+	}
+
+	msg.Sections = append(append([]api.Section{}, msg.Sections...), api.Section{
+		Buttons: buttons,
+		Context: api.ContextItems{
+			{Text: fmt.Sprintf("Page %d of %d", page+1, len(o.pages))},
+		},
+	})
+	return msg
+}
+
+func (o *pagedOutput) pageCmd(page int) string {
+	return fmt.Sprintf("%s %s %s %d", command.ShowVerb, outputFeatureName.Name, o.id, page)
+}
+
+// splitIntoPages splits a given output into pages with at most pageSize lines. A page is also closed earlier
+// when it exceeds the maxPageLength.
+func splitIntoPages(in string, pageSize int) []string {
+	if in == "" || pageSize <= 0 {
+		return nil
+	}
+
+	var (
+		pages []string
+		lines []string
+		size  int
+	)
+	for _, line := range strings.Split(in, "\n") {
+		if len(lines) > 0 && (len(lines) >= pageSize || size+len(line) > maxPageLength) {
+			pages = append(pages, strings.Join(lines, "\n"))
+			lines, size = nil, 0
+		}
+		lines = append(lines, line)
+		size += len(line) + 1
+	}
+	if len(lines) > 0 {
+		pages = append(pages, strings.Join(lines, "\n"))
+	}
+	return pages
+}
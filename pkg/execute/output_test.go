@@ -0,0 +1,212 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestOutputExecutorPagination(t *testing.T) {
+	// given
+	executor := NewOutputExecutor(loggerx.NewNoop(), config.OutputPagination{
+		Enabled:  true,
+		PageSize: 2,
+		MaxPages: 3,
+		CacheTTL: time.Minute,
+	})
+	cmdCtx := fixApprovalCmdCtx("kubectl get pods", "alice")
+	in := fixCodeBlockMsg("pod-1", "pod-2", "pod-3", "pod-4", "pod-5")
+
+	// when
+	first := executor.Paginate(in, cmdCtx)
+
+	// then
+	assert.Equal(t, "pod-1\npod-2", first.BaseBody.CodeBlock)
+	assert.False(t, first.ReplaceOriginal)
+	require.Len(t, first.Sections, 1)
+	assert.Equal(t, "Page 1 of 3", first.Sections[0].Context[0].Text)
+	require.Len(t, first.Sections[0].Buttons, 1)
+	assert.Equal(t, "Next", first.Sections[0].Buttons[0].Name)
+
+	nextCmd := strings.TrimPrefix(first.Sections[0].Buttons[0].Command, api.MessageBotNamePlaceholder+" ")
+	id := strings.Fields(nextCmd)[2]
+	assert.Equal(t, fmt.Sprintf("show output %s 2", id), nextCmd)
+
+	// when
+	last, err := executor.Show(context.Background(), fixApprovalCmdCtx(fmt.Sprintf("show output %s 3", id), "alice"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "pod-5", last.BaseBody.CodeBlock)
+	assert.Equal(t, in.Description, last.Description)
+	assert.True(t, last.ReplaceOriginal)
+	require.Len(t, last.Sections, 1)
+	assert.Equal(t, "Page 3 of 3", last.Sections[0].Context[0].Text)
+	require.Len(t, last.Sections[0].Buttons, 1)
+	assert.Equal(t, "Previous", last.Sections[0].Buttons[0].Name)
+	assert.Equal(t, fmt.Sprintf("%s show output %s 2", api.MessageBotNamePlaceholder, id), last.Sections[0].Buttons[0].Command)
+
+	// when
+	outOfRange, err := executor.Show(context.Background(), fixApprovalCmdCtx(fmt.Sprintf("show output %s 4", id), "alice"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("Output %q has 3 pages.", id), outOfRange.BaseBody.CodeBlock)
+}
+
+func TestOutputExecutorSkipsPagination(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      config.OutputPagination
+		platform config.CommPlatformIntegration
+		lines    []string
+	}{
+		{
+			name:     "Disabled",
+			cfg:      config.OutputPagination{PageSize: 2, CacheTTL: time.Minute},
+			platform: config.SocketSlackCommPlatformIntegration,
+			lines:    []string{"a", "b", "c"},
+		},
+		{
+			name:     "Non-interactive platform",
+			cfg:      config.OutputPagination{Enabled: true, PageSize: 2, CacheTTL: time.Minute},
+			platform: config.DiscordCommPlatformIntegration,
+			lines:    []string{"a", "b", "c"},
+		},
+		{
+			name:     "Single page",
+			cfg:      config.OutputPagination{Enabled: true, PageSize: 2, CacheTTL: time.Minute},
+			platform: config.SocketSlackCommPlatformIntegration,
+			lines:    []string{"a", "b"},
+		},
+		{
+			name:     "Too many pages",
+			cfg:      config.OutputPagination{Enabled: true, PageSize: 1, MaxPages: 2, CacheTTL: time.Minute},
+			platform: config.SocketSlackCommPlatformIntegration,
+			lines:    []string{"a", "b", "c"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			executor := NewOutputExecutor(loggerx.NewNoop(), tc.cfg)
+			cmdCtx := fixApprovalCmdCtx("kubectl get pods", "alice")
+			cmdCtx.Platform = tc.platform
+			in := fixCodeBlockMsg(tc.lines...)
+
+			// when
+			out := executor.Paginate(in, cmdCtx)
+
+			// then
+			assert.Equal(t, in, out)
+		})
+	}
+}
+
+func TestOutputExecutorExpiredOutput(t *testing.T) {
+	// given
+	now := time.Now()
+	executor := NewOutputExecutor(loggerx.NewNoop(), config.OutputPagination{
+		Enabled:  true,
+		PageSize: 1,
+		CacheTTL: time.Minute,
+	})
+	executor.now = func() time.Time { return now }
+
+	msg := executor.Paginate(fixCodeBlockMsg("a", "b"), fixApprovalCmdCtx("kubectl get pods", "alice"))
+	nextCmd := strings.TrimPrefix(msg.Sections[0].Buttons[0].Command, api.MessageBotNamePlaceholder+" ")
+	id := strings.Fields(nextCmd)[2]
+
+	// when
+	now = now.Add(2 * time.Minute)
+	out, err := executor.Show(context.Background(), fixApprovalCmdCtx(nextCmd, "alice"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("Output %q doesn't exist or it has already expired. Run the command again.", id), out.BaseBody.CodeBlock)
+}
+
+func TestOutputExecutorRejectsOtherConversations(t *testing.T) {
+	// given
+	executor := NewOutputExecutor(loggerx.NewNoop(), config.OutputPagination{
+		Enabled:  true,
+		PageSize: 1,
+		CacheTTL: time.Minute,
+	})
+	cmdCtx := fixApprovalCmdCtx("kubectl get secrets", "alice")
+	cmdCtx.Conversation.ID = "C-ops"
+
+	msg := executor.Paginate(fixCodeBlockMsg("a", "b"), cmdCtx)
+	nextCmd := strings.TrimPrefix(msg.Sections[0].Buttons[0].Command, api.MessageBotNamePlaceholder+" ")
+	id := strings.Fields(nextCmd)[2]
+
+	otherCtx := fixApprovalCmdCtx(nextCmd, "bob")
+	otherCtx.Conversation.ID = "C-random"
+
+	// when
+	out, err := executor.Show(context.Background(), otherCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("Output %q doesn't exist or it has already expired. Run the command again.", id), out.BaseBody.CodeBlock)
+}
+
+func TestOutputExecutorEvictsOldestOutputs(t *testing.T) {
+	// given
+	now := time.Now()
+	executor := NewOutputExecutor(loggerx.NewNoop(), config.OutputPagination{
+		Enabled:  true,
+		PageSize: 1,
+		CacheTTL: time.Hour,
+	})
+	executor.now = func() time.Time { return now }
+
+	var firstID string
+	for i := 0; i < maxCachedOutputs+1; i++ {
+		now = now.Add(time.Second)
+		msg := executor.Paginate(fixCodeBlockMsg("a", "b"), fixApprovalCmdCtx("kubectl get pods", "alice"))
+		if i == 0 {
+			firstID = strings.Fields(msg.Sections[0].Buttons[0].Command)[3]
+		}
+	}
+
+	// when
+	out, err := executor.Show(context.Background(), fixApprovalCmdCtx(fmt.Sprintf("show output %s 2", firstID), "alice"))
+
+	// then
+	require.NoError(t, err)
+	assert.Len(t, executor.outputs, maxCachedOutputs)
+	assert.Equal(t, fmt.Sprintf("Output %q doesn't exist or it has already expired. Run the command again.", firstID), out.BaseBody.CodeBlock)
+}
+
+func TestSplitIntoPages(t *testing.T) {
+	// given
+	longLine := strings.Repeat("x", maxPageLength)
+
+	// when
+	pages := splitIntoPages(strings.Join([]string{"a", "b", "c", longLine, "d"}, "\n"), 2)
+
+	// then
+	assert.Equal(t, []string{"a\nb", "c", longLine, "d"}, pages)
+}
+
+func fixCodeBlockMsg(lines ...string) interactive.CoreMessage {
+	return interactive.CoreMessage{
+		Description: "`kubectl get pods` on `dev`",
+		Message: api.Message{
+			BaseBody: api.Body{
+				CodeBlock: strings.Join(lines, "\n"),
+			},
+		},
+	}
+}
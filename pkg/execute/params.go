@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/mattn/go-shellwords"
@@ -14,6 +15,7 @@ const (
 	cantParseCmd           = "cannot parse command. Please use 'help' to see supported commands"
 	incorrectParamFlag     = "incorrect use of %s flag: %s"
 	missingCmdParamValue   = `incorrect use of %s flag: an argument is missing. use %s="value" or %s value`
+	notPositiveNumberParam = "incorrect use of %s flag: %q is not a positive number"
 	multipleParams         = "incorrect use of %s flag: found more than one %s flag"
 	paramFlagParseErrorMsg = `incorrect use of %s flag: could not parse flag in %s
 error: %s
@@ -27,6 +29,16 @@ type Flags struct {
 	ClusterName  string
 	TokenizedCmd []string
 	CmdHeader    string
+	// InvertFilter holds the --grep-v value. Lines containing it are removed from the output.
+	InvertFilter string
+	// Head and Tail limit the output to the first or last N lines. Zero means no limit.
+	// They are passed with the --bk-head and --bk-tail flags, so they don't collide with plugin flags, such as 'kubectl logs --tail'.
+	Head int
+	Tail int
+	// Columns holds the table columns to display.
+	Columns []string
+	// JSONPath is a template applied on the JSON output.
+	JSONPath string
 }
 
 // ParseFlags parses raw cmd and removes optional params with flags.
@@ -52,6 +64,11 @@ func ParseFlags(cmd string) (Flags, error) {
 		return Flags{}, err
 	}
 
+	cmd, outFlags, err := extractOutputParams(cmd)
+	if err != nil {
+		return Flags{}, err
+	}
+
 	tokenized, err := shellwords.Parse(cmd)
 	if err != nil {
 		return Flags{}, errors.New(cantParseCmd)
@@ -62,9 +79,73 @@ func ParseFlags(cmd string) (Flags, error) {
 		ClusterName:  clusterName,
 		TokenizedCmd: tokenized,
 		CmdHeader:    cmdHeaderName,
+		InvertFilter: outFlags.InvertFilter,
+		Head:         outFlags.Head,
+		Tail:         outFlags.Tail,
+		Columns:      outFlags.Columns,
+		JSONPath:     outFlags.JSONPath,
 	}, nil
 }
 
+// extractOutputParams extracts flags which are applied on the executor output, so they work for all plugins.
+func extractOutputParams(cmd string) (string, Flags, error) {
+	var out Flags
+
+	cmd, invertFilter, err := extractParam(cmd, "grep-v")
+	if err != nil {
+		return "", Flags{}, err
+	}
+	out.InvertFilter = invertFilter
+
+	cmd, out.Head, err = extractPositiveIntParam(cmd, "bk-head")
+	if err != nil {
+		return "", Flags{}, err
+	}
+
+	cmd, out.Tail, err = extractPositiveIntParam(cmd, "bk-tail")
+	if err != nil {
+		return "", Flags{}, err
+	}
+
+	cmd, columns, err := extractParam(cmd, "columns")
+	if err != nil {
+		return "", Flags{}, err
+	}
+	for _, col := range strings.Split(columns, ",") {
+		col = strings.TrimSpace(col)
+		if col == "" {
+			continue
+		}
+		out.Columns = append(out.Columns, col)
+	}
+
+	cmd, jsonPath, err := extractParam(cmd, "jsonpath")
+	if err != nil {
+		return "", Flags{}, err
+	}
+	if jsonPath != "" {
+		if _, err := parseJSONPath(jsonPath); err != nil {
+			return "", Flags{}, fmt.Errorf(incorrectParamFlag, "--jsonpath", err)
+		}
+	}
+	out.JSONPath = jsonPath
+
+	return cmd, out, nil
+}
+
+func extractPositiveIntParam(cmd, flagName string) (string, int, error) {
+	cmd, val, err := extractParam(cmd, flagName)
+	if err != nil || val == "" {
+		return cmd, 0, err
+	}
+
+	out, err := strconv.Atoi(val)
+	if err != nil || out < 1 {
+		return "", 0, fmt.Errorf(notPositiveNumberParam, fmt.Sprintf("--%s", flagName), val)
+	}
+	return cmd, out, nil
+}
+
 func extractParam(cmd, flagName string) (string, string, error) {
 	flag := fmt.Sprintf("--%s", flagName)
 	var withParam string
@@ -138,3 +138,64 @@ func TestExtractExecutorFilter_WithErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOutputFlags(t *testing.T) {
+	// when
+	flags, err := ParseFlags(`kubectl get po -A --grep-v=kube-system --bk-head 10 --bk-tail=5 --columns "NAME, STATUS" --cluster-name=dev`)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "kubectl get po -A", flags.CleanCmd)
+	assert.Equal(t, []string{"kubectl", "get", "po", "-A"}, flags.TokenizedCmd)
+	assert.Equal(t, "dev", flags.ClusterName)
+	assert.Equal(t, "kube-system", flags.InvertFilter)
+	assert.Equal(t, 10, flags.Head)
+	assert.Equal(t, 5, flags.Tail)
+	assert.Equal(t, []string{"NAME", "STATUS"}, flags.Columns)
+
+	// when
+	flags, err = ParseFlags(`kubectl get po -o json --jsonpath='{.items[*].metadata.name}'`)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "kubectl get po -o json", flags.CleanCmd)
+	assert.Equal(t, "{.items[*].metadata.name}", flags.JSONPath)
+
+	// when
+	flags, err = ParseFlags(`kubectl logs deploy/nginx --tail=-1 --bk-tail=20`)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "kubectl logs deploy/nginx --tail=-1", flags.CleanCmd)
+	assert.Equal(t, 20, flags.Tail)
+}
+
+func TestParseOutputFlags_WithErrors(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Cmd    string
+		ErrMsg string
+	}{
+		{
+			Name:   "raise error when head value is not a number",
+			Cmd:    "kubectl get po --bk-head=ten",
+			ErrMsg: `incorrect use of --bk-head flag: "ten" is not a positive number`,
+		},
+		{
+			Name:   "raise error when tail value is not positive",
+			Cmd:    "kubectl get po --bk-tail=0",
+			ErrMsg: `incorrect use of --bk-tail flag: "0" is not a positive number`,
+		},
+		{
+			Name:   "raise error when jsonpath template is malformed",
+			Cmd:    "kubectl get po -o json --jsonpath={.items[*}",
+			ErrMsg: `incorrect use of --jsonpath flag`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := ParseFlags(tc.Cmd)
+			assert.ErrorContains(t, err, tc.ErrMsg)
+		})
+	}
+}
@@ -24,15 +24,17 @@ type PluginExecutor struct {
 	cfg           config.Config
 	pluginManager *plugin.Manager
 	restCfg       *rest.Config
+	output        *OutputExecutor
 }
 
 // NewPluginExecutor creates a new instance of PluginExecutor.
-func NewPluginExecutor(log logrus.FieldLogger, cfg config.Config, manager *plugin.Manager, restCfg *rest.Config) *PluginExecutor {
+func NewPluginExecutor(log logrus.FieldLogger, cfg config.Config, manager *plugin.Manager, restCfg *rest.Config, output *OutputExecutor) *PluginExecutor {
 	return &PluginExecutor{
 		log:           log,
 		cfg:           cfg,
 		pluginManager: manager,
 		restCfg:       restCfg,
+		output:        output,
 	}
 }
 
@@ -83,6 +85,11 @@ func (e *PluginExecutor) Execute(ctx context.Context, bindings []string, slackSt
 
 	input := plugin.KubeConfigInput{
 		Channel: cmdCtx.Conversation.DisplayName,
+		User: plugin.PlatformUserInput{
+			ID:     cmdCtx.User.ID,
+			Email:  cmdCtx.User.Email,
+			Groups: cmdCtx.User.Groups,
+		},
 	}
 	kubeconfig, err := plugin.GenerateKubeConfig(e.restCfg, e.cfg.Settings.ClusterName, plugins[0].Context, input)
 	if err != nil {
@@ -207,6 +214,7 @@ func emptyMsg(cmdCtx CommandContext) interactive.CoreMessage {
 }
 
 // filterMessage takes into account only base plaintext + code block, all other properties are ignored.
+// Long code blocks are split into pages if the output pagination is enabled.
 // This method should be called only for message type api.BaseBodyWithFilterMessage.
 func (e *PluginExecutor) filterMessage(msg api.Message, cmdCtx CommandContext) interactive.CoreMessage {
 	code := cmdCtx.ExecutorFilter.Apply(msg.BaseBody.CodeBlock)
@@ -226,7 +234,11 @@ func (e *PluginExecutor) filterMessage(msg api.Message, cmdCtx CommandContext) i
 	}
 
 	allLines := code + plaintext
-	return appendInteractiveFilterIfNeeded(allLines, outMsg, cmdCtx)
+	outMsg = appendInteractiveFilterIfNeeded(allLines, outMsg, cmdCtx)
+	if e.output != nil {
+		outMsg = e.output.Paginate(outMsg, cmdCtx)
+	}
+	return outMsg
 }
 
 func (e *PluginExecutor) collectConfigs(plugins []config.Plugin) ([]*executor.Config, error) {
@@ -252,6 +264,21 @@ func (e *PluginExecutor) collectConfigs(plugins []config.Plugin) ([]*executor.Co
 	return configs, nil
 }
 
+// GetApprovalPolicy returns the approval policy for a given command. It returns nil if no policy is defined.
+func (e *PluginExecutor) GetApprovalPolicy(bindings []string, args []string) *config.ApprovalPolicy {
+	if len(args) == 0 {
+		return nil
+	}
+
+	plugins, _ := e.getEnabledPlugins(bindings, args[0])
+	for _, p := range plugins {
+		if p.Approval != nil {
+			return p.Approval
+		}
+	}
+	return nil
+}
+
 func (e *PluginExecutor) getEnabledPlugins(bindings []string, cmdName string) ([]config.Plugin, string) {
 	var (
 		out            []config.Plugin
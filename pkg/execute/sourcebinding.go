@@ -252,7 +252,14 @@ func (e *SourceBindingExecutor) currentlySelectedOptions(commGroupName string, p
 			return channel.Bindings.Sources
 		}
 	case config.TeamsCommPlatformIntegration:
-		return e.cfg.Communications[commGroupName].Teams.Bindings.Sources
+		teams := e.cfg.Communications[commGroupName].Teams
+		for _, channel := range teams.Channels {
+			if channel.Identifier() != conversationID {
+				continue
+			}
+			return channel.Bindings.Sources
+		}
+		return teams.Bindings.Sources
 	}
 	return nil
 }
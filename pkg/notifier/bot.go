@@ -2,6 +2,7 @@ package notifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/kubeshop/botkube/pkg/api"
@@ -17,8 +18,14 @@ type Bot interface {
 	// TODO: Consider option per channel to turn on/off "announcements" (Botkube start/stop/upgrade, notify/config change).
 	SendMessageToAll(context.Context, interactive.CoreMessage) error
 
-	// SendMessage sends a generic message for a given source bindings.
-	SendMessage(context.Context, interactive.CoreMessage, []string) error
+	// SendMessage sends a generic message for a given source bindings. If channels are specified, only those channels are notified.
+	// It returns references to the posted messages. Integrations which cannot identify posted messages return no references.
+	// If the message couldn't be delivered to some of the channels, a ChannelDeliveryError is returned.
+	SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string) ([]MessageRef, error)
+
+	// ChannelsToNotify returns identifiers of channels bound to given source bindings. The identifiers are the same as
+	// the ones used to limit deliveries in SendMessage.
+	ChannelsToNotify(sourceBindings []string) []string
 
 	// IntegrationName returns a name of a given communication platform.
 	IntegrationName() config.CommPlatformIntegration
@@ -27,6 +34,90 @@ type Bot interface {
 	Type() config.IntegrationType
 }
 
+// ThreadedBot is a Bot which groups correlated messages in threads.
+type ThreadedBot interface {
+	Bot
+
+	// SendCorrelatedMessage sends a message for a given source bindings. The first message for a given correlation key is posted
+	// as a new message. Follow-up messages are posted as thread replies, and the first message is edited to show the latest status.
+	// If channels are specified, only those channels are notified. If the message couldn't be delivered to some of the channels,
+	// a ChannelDeliveryError is returned.
+	SendCorrelatedMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, channels []string, correlationKey string) error
+}
+
+// EditableBot is a Bot which can edit already posted messages.
+type EditableBot interface {
+	Bot
+
+	// EditMessage replaces the content of a given message.
+	EditMessage(ctx context.Context, ref MessageRef, msg interactive.CoreMessage) error
+}
+
+// MessageRef identifies a message posted on a communication platform.
+type MessageRef struct {
+	// Channel is the channel identifier used to limit deliveries, e.g. channel name on Slack.
+	Channel string `json:"channel,omitempty"`
+	// ChannelID is the ID of a channel where the message was posted.
+	ChannelID string `json:"channelID"`
+	// MessageID is the platform-specific message ID, e.g. Slack message timestamp.
+	MessageID string `json:"messageID"`
+	// ThreadID is set for platforms where thread replies are posted to a separate channel, e.g. Discord threads.
+	ThreadID string `json:"threadID,omitempty"`
+}
+
+// ChannelDeliveryError is returned when a message couldn't be delivered to some of the channels.
+// It allows retrying the delivery only for the failed channels.
+type ChannelDeliveryError struct {
+	// Channels contains identifiers of channels where the message wasn't delivered.
+	Channels []string
+	Err      error
+}
+
+// NewChannelDeliveryError returns an error for given failed channels. It returns nil if there is no error.
+func NewChannelDeliveryError(channels []string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &ChannelDeliveryError{Channels: channels, Err: err}
+}
+
+// Error returns the underlying error message.
+func (e *ChannelDeliveryError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ChannelDeliveryError) Unwrap() error {
+	return e.Err
+}
+
+// FailedChannels returns channels where a message wasn't delivered. It returns false if a given error doesn't identify failed channels.
+func FailedChannels(err error) ([]string, bool) {
+	var chErr *ChannelDeliveryError
+	if !errors.As(err, &chErr) || len(chErr.Channels) == 0 {
+		return nil, false
+	}
+	return chErr.Channels, true
+}
+
+// FilterChannels returns channels which are also present on the allowed list. If the allowed list is empty, all channels are returned.
+func FilterChannels(channels, allowed []string) []string {
+	if len(allowed) == 0 {
+		return channels
+	}
+
+	var out []string
+	for _, ch := range channels {
+		for _, allowedCh := range allowed {
+			if ch == allowedCh {
+				out = append(out, ch)
+				break
+			}
+		}
+	}
+	return out
+}
+
 // SendPlaintextMessage sends a plaintext message to specified providers.
 func SendPlaintextMessage(ctx context.Context, notifiers []Bot, msg string) error {
 	if msg == "" {